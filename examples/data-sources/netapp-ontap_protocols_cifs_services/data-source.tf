@@ -0,0 +1,7 @@
+data "netapp-ontap_protocols_cifs_services_data_source" "protocols_cifs_services" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    domain = "EXAMPLE.COM"
+  }
+}
@@ -0,0 +1,6 @@
+data "netapp-ontap_protocols_cifs_share_data_source" "protocols_cifs_share" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "share1"
+}
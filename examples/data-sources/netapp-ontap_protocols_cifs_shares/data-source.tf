@@ -0,0 +1,8 @@
+data "netapp-ontap_protocols_cifs_shares_data_source" "protocols_cifs_shares" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    name = "share*"
+  }
+}
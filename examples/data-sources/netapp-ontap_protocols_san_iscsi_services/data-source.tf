@@ -0,0 +1,7 @@
+data "netapp-ontap_protocols_san_iscsi_services_data_source" "protocols_san_iscsi_services" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm*"
+  }
+}
@@ -0,0 +1,17 @@
+data "netapp-ontap_rest_api_data_source" "disks" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  api = "storage/disks"
+  query = {
+    container_type = "spare"
+  }
+  fields = ["name", "node.name", "usable_size"]
+}
+
+output "spare_disks" {
+  value = [for disk in data.netapp-ontap_rest_api_data_source.disks.records : disk["name"]]
+}
+
+output "spare_disk_sizes" {
+  value = { for disk in jsondecode(data.netapp-ontap_rest_api_data_source.disks.records_json) : disk.name => disk.usable_size }
+}
@@ -0,0 +1,6 @@
+data "netapp-ontap_san_igroup_data_source" "san_igroup" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "igroup1"
+}
@@ -0,0 +1,8 @@
+data "netapp-ontap_san_igroups_data_source" "san_igroups" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    name = "igroup*"
+  }
+}
@@ -10,7 +10,7 @@ terraform {
 
 provider "netapp-ontap" {
   # A connection profile defines how to interface with an ONTAP cluster or svm.
-  # At least one is required.
+  # At least one is required, in connection_profiles, in credentials_file, or with environment variables.
   connection_profiles = [
     {
       name = "cluster1"
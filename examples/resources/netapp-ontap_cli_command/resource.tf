@@ -0,0 +1,45 @@
+# vserver nfs modify -vserver svm1 -v4.1-read-delegation enabled
+resource "netapp-ontap_cli_command_resource" "nfs_read_delegation" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  create = {
+    command = "vserver nfs"
+    verb = "PATCH"
+    params = {
+      vserver = "svm1"
+    }
+    body = jsonencode({
+      "v4.1-read-delegation" = "enabled"
+    })
+  }
+  read = {
+    command = "vserver nfs"
+    params = {
+      vserver = "svm1"
+      fields = "v4.1-read-delegation"
+    }
+  }
+  destroy = {
+    command = "vserver nfs"
+    verb = "PATCH"
+    params = {
+      vserver = "svm1"
+    }
+    body = jsonencode({
+      "v4.1-read-delegation" = "disabled"
+    })
+  }
+}
+
+# vserver show -vserver svm1 -fields ipspace
+resource "netapp-ontap_cli_command_resource" "svm_ipspace" {
+  cx_profile_name = "cluster4"
+  read_only = true
+  read = {
+    command = "vserver"
+    params = {
+      vserver = "svm1"
+      fields = "ipspace"
+    }
+  }
+}
@@ -0,0 +1,20 @@
+resource "netapp-ontap_protocols_cifs_service_resource" "protocols_cifs_service" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "CIFS1"
+  comment = "managed by terraform"
+  ad_domain = {
+    fqdn = "example.com"
+    organizational_unit = "CN=Computers"
+    user = "administrator"
+    password = var.ad_password
+  }
+  netbios = {
+    aliases = ["CIFS1ALIAS"]
+  }
+  security = {
+    smb_signing = true
+    smb_encryption = true
+  }
+}
@@ -0,0 +1,11 @@
+resource "netapp-ontap_protocols_cifs_share_resource" "protocols_cifs_share" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "share1"
+  path = "/vol1"
+  comment = "managed by terraform"
+  continuously_available = false
+  oplocks = true
+  access_based_enumeration = true
+}
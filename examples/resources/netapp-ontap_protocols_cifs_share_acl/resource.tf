@@ -0,0 +1,9 @@
+resource "netapp-ontap_protocols_cifs_share_acl_resource" "protocols_cifs_share_acl" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  share_name = "share1"
+  user_or_group = "EXAMPLE\\admins"
+  type = "windows"
+  permission = "full_control"
+}
@@ -0,0 +1,6 @@
+resource "netapp-ontap_protocols_san_fcp_service_resource" "protocols_san_fcp_service" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  enabled = true
+}
@@ -0,0 +1,7 @@
+resource "netapp-ontap_protocols_san_iscsi_service_resource" "protocols_san_iscsi_service" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  enabled = true
+  target_alias = "svm1_target"
+}
@@ -0,0 +1,20 @@
+resource "netapp-ontap_rest_api_resource" "qtree" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  api = "storage/qtrees"
+  create_body = jsonencode({
+    name = "qtree1"
+    svm = { name = "svm1" }
+    volume = { name = "vol1" }
+  })
+  patch_body = jsonencode({
+    security_style = "ntfs"
+  })
+  key_query = {
+    name = "qtree1"
+    "svm.name" = "svm1"
+    "volume.name" = "vol1"
+  }
+  delete_path = "storage/qtrees/{volume.uuid}/{id}"
+  drift_fields = ["security_style"]
+}
@@ -0,0 +1,18 @@
+resource "netapp-ontap_san_igroup_resource" "san_igroup" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "igroup1"
+  os_type = "linux"
+  protocol = "iscsi"
+  comment = "managed by terraform"
+  initiators = [
+    {
+      name = "iqn.1994-05.com.redhat:host1"
+      comment = "host1"
+    },
+    {
+      name = "iqn.1994-05.com.redhat:host2"
+    },
+  ]
+}
@@ -0,0 +1,12 @@
+resource "netapp-ontap_san_lun_resource" "san_lun" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "/vol/vol1/lun1"
+  os_type = "linux"
+  size = 10
+  size_unit = "gb"
+  space_reserve = false
+  qos_policy_name = "qos1"
+  comment = "managed by terraform"
+}
@@ -0,0 +1,8 @@
+resource "netapp-ontap_san_lun_map_resource" "san_lun_map" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  lun_name = "/vol/vol1/lun1"
+  igroup_name = "igroup1"
+  logical_unit_number = 1
+}
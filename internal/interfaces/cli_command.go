@@ -0,0 +1,62 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// CLICommand describes a CLI command run through the private CLI passthrough, eg
+// "vserver nfs modify -vserver svm1 -v4.1 enabled" is {Command: "vserver nfs", Verb: "PATCH", Params: {vserver: svm1}, Body: {v4.1: enabled}}
+type CLICommand struct {
+	Command string
+	Verb    string
+	Params  map[string]string
+	Body    map[string]interface{}
+}
+
+// CLICommandPath converts a command to its private CLI path, eg vserver nfs to private/cli/vserver/nfs
+func CLICommandPath(command string) string {
+	return "private/cli/" + strings.Join(strings.Fields(strings.ReplaceAll(command, "/", " ")), "/")
+}
+
+// RunCLICommand runs a CLI command, and returns the records in the output, if any.
+// GET, POST, PATCH and DELETE are supported.  POST, PATCH and DELETE wait for the job to complete, if any.
+func RunCLICommand(errorHandler *utils.ErrorHandler, r restclient.RestClient, command CLICommand) ([]map[string]interface{}, error) {
+	api := CLICommandPath(command.Command)
+	query := r.NewQuery()
+	for key, value := range command.Params {
+		query.Set(key, value)
+	}
+
+	var statusCode int
+	var response restclient.RestResponse
+	var records []map[string]interface{}
+	var err error
+	switch command.Verb {
+	case "GET":
+		statusCode, records, err = r.GetZeroOrMoreRecords(api, query, command.Body)
+	case "POST":
+		statusCode, response, err = r.CallCreateMethod(api, query, command.Body)
+		records = response.Records
+	case "PATCH":
+		statusCode, response, err = r.CallUpdateMethod(api, query, command.Body)
+		records = response.Records
+	case "DELETE":
+		statusCode, response, err = r.CallDeleteMethod(api, query, command.Body)
+		records = response.Records
+	default:
+		return nil, errorHandler.MakeAndReportError("unsupported verb", fmt.Sprintf("verb %s is not supported for %s, expecting one of GET, POST, PATCH, DELETE", command.Verb, command.Command))
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError(fmt.Sprintf("error running %s", command.Command), fmt.Sprintf("error on %s %s: %s, statusCode %d", command.Verb, api, err, statusCode), err)
+	}
+	if records == nil {
+		records = []map[string]interface{}{}
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("%s %s records: %d", command.Verb, api, len(records)))
+	return records, nil
+}
@@ -0,0 +1,82 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestCLICommandPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    string
+	}{
+		{name: "test_spaces", command: "vserver nfs", want: "private/cli/vserver/nfs"},
+		{name: "test_extra_spaces", command: " volume  efficiency ", want: "private/cli/volume/efficiency"},
+		{name: "test_slashes", command: "/security/login/", want: "private/cli/security/login"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CLICommandPath(tt.command); got != tt.want {
+				t.Errorf("CLICommandPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCLICommand(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	record := map[string]interface{}{"vserver": "svm1", "v4.1": "enabled"}
+	params := map[string]string{"vserver": "svm1"}
+	body := map[string]interface{}{"v4.1": "enabled"}
+	tests := []struct {
+		name      string
+		command   CLICommand
+		responses []restclient.MockResponse
+		want      []map[string]interface{}
+		wantErr   bool
+	}{
+		{name: "test_get_1", command: CLICommand{Command: "vserver nfs", Verb: "GET", Params: params}, responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "private/cli/vserver/nfs", ExpectedQuery: "vserver=svm1", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]interface{}{record}}},
+		}, want: []map[string]interface{}{record}, wantErr: false},
+		{name: "test_get_no_record", command: CLICommand{Command: "vserver nfs", Verb: "GET", Params: params}, responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "private/cli/vserver/nfs", ExpectedQuery: "vserver=svm1", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 0, Records: []map[string]interface{}{}}},
+		}, want: []map[string]interface{}{}, wantErr: false},
+		{name: "test_post_1", command: CLICommand{Command: "vserver nfs", Verb: "POST", Params: params, Body: body}, responses: []restclient.MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "private/cli/vserver/nfs", ExpectedQuery: "return_timeout=60&vserver=svm1", ExpectedBody: body, StatusCode: 201, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]interface{}{{"cli_output": "done"}}}},
+		}, want: []map[string]interface{}{{"cli_output": "done"}}, wantErr: false},
+		{name: "test_patch_1", command: CLICommand{Command: "vserver nfs", Verb: "PATCH", Params: params, Body: body}, responses: []restclient.MockResponse{
+			{ExpectedMethod: "PATCH", ExpectedURL: "private/cli/vserver/nfs", ExpectedQuery: "return_timeout=60&vserver=svm1", ExpectedBody: body, StatusCode: 200, Response: restclient.RestResponse{}},
+		}, want: []map[string]interface{}{}, wantErr: false},
+		{name: "test_delete_1", command: CLICommand{Command: "vserver nfs", Verb: "DELETE", Params: params}, responses: []restclient.MockResponse{
+			{ExpectedMethod: "DELETE", ExpectedURL: "private/cli/vserver/nfs", ExpectedQuery: "return_timeout=60&vserver=svm1", StatusCode: 200, Response: restclient.RestResponse{}},
+		}, want: []map[string]interface{}{}, wantErr: false},
+		{name: "test_error_1", command: CLICommand{Command: "vserver nfs", Verb: "PATCH", Params: params, Body: body}, responses: []restclient.MockResponse{
+			{ExpectedMethod: "PATCH", ExpectedURL: "private/cli/vserver/nfs", StatusCode: 500, Response: restclient.RestResponse{}, Err: genericError},
+		}, want: nil, wantErr: true},
+		{name: "test_unsupported_verb", command: CLICommand{Command: "vserver nfs", Verb: "OPTIONS"}, responses: []restclient.MockResponse{}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := RunCLICommand(errorHandler, *r, tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunCLICommand() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunCLICommand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
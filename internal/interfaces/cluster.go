@@ -14,15 +14,12 @@ type ClusterGetDataModelONTAP struct {
 	// ConfigurableAttribute types.String `json:"configurable_attribute"`
 	// ID                    types.String `json:"id"`
 	Name    string
+	UUID    string
 	Version versionModelONTAP
 }
 
-type versionModelONTAP struct {
-	Full       string
-	Generation int
-	Major      int
-	Minor      int
-}
+// versionModelONTAP is shared with the REST client, where the cluster version is cached
+type versionModelONTAP = restclient.ClusterVersion
 
 type ipAddress struct {
 	Address string
@@ -46,7 +43,7 @@ func GetCluster(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*Clu
 		err = fmt.Errorf("no response for GET cluster")
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading cluster info", fmt.Sprintf("error on GET cluster: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading cluster info", fmt.Sprintf("error on GET cluster: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP ClusterGetDataModelONTAP
@@ -57,6 +54,27 @@ func GetCluster(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*Clu
 	return &dataONTAP, nil
 }
 
+// GetClusterInfo returns the cluster name, UUID and version.
+// GetCluster is only called once per connection profile, the result is cached in the REST client.
+func GetClusterInfo(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*restclient.ClusterInfo, error) {
+	info, err := r.GetClusterInfo(func() (restclient.ClusterInfo, error) {
+		cluster, err := GetCluster(errorHandler, r)
+		if err != nil {
+			return restclient.ClusterInfo{}, err
+		}
+		return restclient.ClusterInfo{
+			Name:    cluster.Name,
+			UUID:    cluster.UUID,
+			Version: cluster.Version,
+		}, nil
+	})
+	if err != nil {
+		// error reporting done inside GetCluster
+		return nil, err
+	}
+	return &info, nil
+}
+
 // GetClusterNodes to get cluster nodes info
 func GetClusterNodes(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]ClusterNodeGetDataModelONTAP, error) {
 
@@ -65,7 +83,7 @@ func GetClusterNodes(errorHandler *utils.ErrorHandler, r restclient.RestClient)
 
 	statusCode, records, err := r.GetZeroOrMoreRecords("cluster/nodes", query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading cluster nodes info", fmt.Sprintf("error on GET cluster/nodes: %s", err))
+		return nil, errorHandler.MakeAndReportRestError("error reading cluster nodes info", fmt.Sprintf("error on GET cluster/nodes: %s", err), err)
 	}
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read cluster data source NODES - records: %#v", records))
 
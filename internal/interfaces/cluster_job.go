@@ -17,7 +17,7 @@ func GetJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid
 		err = fmt.Errorf("no response for GET job")
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading job info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading job info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 
 	}
 	var job jobModel
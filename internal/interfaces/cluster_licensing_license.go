@@ -65,7 +65,7 @@ func GetClusterLicensingLicenseByName(errorHandler *utils.ErrorHandler, r restcl
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading /cluster/licensing/licenses info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading /cluster/licensing/licenses info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP ClusterLicensingLicenseDataSourceModelONTAP
@@ -94,7 +94,7 @@ func GetListClusterLicensingLicenses(errorHandler *utils.ErrorHandler, r restcli
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading /cluster/licensing/licenses info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading /cluster/licensing/licenses info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []ClusterLicensingLicenseDataSourceModelONTAP
@@ -121,7 +121,7 @@ func GetClusterLicensingLicenses(errorHandler *utils.ErrorHandler, r restclient.
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading /cluster/licensing/licenses info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading /cluster/licensing/licenses info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP ClusterLicensingLicenseKeyDataModelONTAP
@@ -148,7 +148,7 @@ func CreateClusterLicensingLicense(errorHandler *utils.ErrorHandler, r restclien
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating /cluster/licensing/licenses", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating /cluster/licensing/licenses", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP ClusterLicensingLicenseKeyDataModelONTAP
@@ -167,7 +167,7 @@ func DeleteClusterLicensingLicense(errorHandler *utils.ErrorHandler, r restclien
 	query.Add("serial_number", serialNumber)
 	statusCode, _, err := r.CallDeleteMethod(api+"/"+name, query, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting /cluster/licensing/licenses", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting /cluster/licensing/licenses", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
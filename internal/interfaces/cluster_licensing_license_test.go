@@ -96,7 +96,7 @@ func TestGetClusterLicensingLicenseByName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -166,7 +166,7 @@ func TestGetListClusterLicensingLicenses(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -236,7 +236,7 @@ func TestGetClusterLicensingLicenses(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -302,7 +302,7 @@ func TestCreateClusterLicensingLicense(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -330,7 +330,7 @@ func TestDeleteClusterLicensingLicense(t *testing.T) {
 			{ExpectedMethod: "DELETE", ExpectedURL: "cluster/licensing/licenses/license_name", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_error_2": {
-			{ExpectedMethod: "GET", ExpectedURL: "cluster/licensing/licenses/license_name", StatusCode: 200, Response: noRecords, Err: genericError},
+			{ExpectedMethod: "DELETE", ExpectedURL: "cluster/licensing/licenses/license_name", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
 	}
 	tests := []struct {
@@ -345,7 +345,7 @@ func TestDeleteClusterLicensingLicense(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
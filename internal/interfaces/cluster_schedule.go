@@ -50,13 +50,13 @@ func GetClusterSchedule(errorHandler *utils.ErrorHandler, r restclient.RestClien
 
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading schedule info",
-			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading schedule info",
+			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 
 	if response == nil {
 		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("schedule %s not found", name))
-		return nil, errorHandler.MakeAndReportError("error reading schedule info", fmt.Sprintf("schedule %s not found", name))
+		return nil, nil
 	}
 
 	var dataONTAP ClusterScheduleGetDataModelONTAP
@@ -92,7 +92,7 @@ func GetListClusterSchedules(errorHandler *utils.ErrorHandler, r restclient.Rest
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading cluster_schedule info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading cluster_schedule info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []ClusterScheduleGetDataModelONTAP
@@ -120,7 +120,7 @@ func CreateClusterSchedule(errorHandler *utils.ErrorHandler, r restclient.RestCl
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating cluster_schedule", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating cluster_schedule", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP ClusterScheduleGetDataModelONTAP
@@ -142,7 +142,7 @@ func UpdateClusterSchedule(errorHandler *utils.ErrorHandler, r restclient.RestCl
 	query.Add("return_records", "true")
 	statusCode, _, err := r.CallUpdateMethod("cluster/schedules/"+id, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating cluster schedule", fmt.Sprintf("error on POST cluster/schedules: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating cluster schedule", fmt.Sprintf("error on POST cluster/schedules: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 
@@ -153,7 +153,7 @@ func DeleteClusterSchedule(errorHandler *utils.ErrorHandler, r restclient.RestCl
 	api := "cluster/schedules"
 	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting cluster_schedule", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting cluster_schedule", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -103,7 +103,7 @@ func TestGetClusterSchedule(t *testing.T) {
 		want      *ClusterScheduleGetDataModelONTAP
 		wantErr   bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_cron_record_1", responses: responses["test_one_cron_record_1"], want: &basicCronRecord, wantErr: false},
 		{name: "test_one_interval_record_1", responses: responses["test_one_interval_record_1"], want: &intervalRecord, wantErr: false},
 		{name: "test_two_cron_records_error", responses: responses["test_two_cron_records_error"], want: nil, wantErr: true},
@@ -111,7 +111,7 @@ func TestGetClusterSchedule(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -179,7 +179,7 @@ func TestCreateClusterSchedule(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -217,7 +217,7 @@ func TestDeleteClusterSchedule(t *testing.T) {
 			{ExpectedMethod: "DELETE", ExpectedURL: "cluster/schedules/1234", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_delete_error": {
-			{ExpectedMethod: "DELETE", ExpectedURL: "cluster/scheduless/1234", StatusCode: 200, Response: noRecords, Err: genericError},
+			{ExpectedMethod: "DELETE", ExpectedURL: "cluster/schedules/1234", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
 	}
 	tests := []struct {
@@ -230,7 +230,7 @@ func TestDeleteClusterSchedule(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -71,7 +71,7 @@ func TestGetCluster(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -90,6 +90,30 @@ func TestGetCluster(t *testing.T) {
 	}
 }
 
+func TestGetClusterInfo(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	record := map[string]any{"name": "cluster1", "uuid": "1234", "version": map[string]any{"full": "NetApp Release 9.10.1", "generation": 9.0, "major": 10.0, "minor": 1.0}}
+	want := &restclient.ClusterInfo{Name: "cluster1", UUID: "1234", Version: restclient.ClusterVersion{Full: "NetApp Release 9.10.1", Generation: 9, Major: 10, Minor: 1}}
+	// a single response is mocked, the second call uses the cached value
+	responses := []restclient.MockResponse{
+		{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}, Err: nil},
+	}
+	r, err := restclient.NewMockedRestClient(t, responses)
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < 2; i++ {
+		got, err := GetClusterInfo(errorHandler, *r)
+		if err != nil {
+			t.Errorf("GetClusterInfo() error = %v", err)
+			return
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetClusterInfo() = %v, want %v", got, want)
+		}
+	}
+}
+
 func TestGetClusterNodes(t *testing.T) {
 
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
@@ -121,19 +145,19 @@ func TestGetClusterNodes(t *testing.T) {
 
 	responses := map[string][]restclient.MockResponse{
 		"test_no_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_one_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: twoRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: twoRecords, Err: nil},
 		},
 		"test_error_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: twoRecords, Err: genericError},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: twoRecords, Err: genericError},
 		},
 		"test_error_2": {
-			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: decodeError, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: decodeError, Err: nil},
 		},
 	}
 	tests := []struct {
@@ -151,7 +175,7 @@ func TestGetClusterNodes(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
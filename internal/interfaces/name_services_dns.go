@@ -31,11 +31,12 @@ func GetNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestClien
 	query.Add("svm.name", svmName)
 	query.Fields([]string{"domains", "servers"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading name_services_dns info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading name_services_dns info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP NameServicesDNSGetDataModelONTAP
@@ -71,7 +72,7 @@ func GetListNameServicesDNSs(errorHandler *utils.ErrorHandler, r restclient.Rest
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading name_services_dns info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading name_services_dns info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []NameServicesDNSGetDataModelONTAP
@@ -99,7 +100,7 @@ func CreateNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestCl
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("carchi8py body is : %#v", body))
 	statusCode, response, err := r.CallCreateMethod("name-services/dns", query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating DNS", fmt.Sprintf("error on POST name-services/dns: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating DNS", fmt.Sprintf("error on POST name-services/dns: %s, statusCode %d", err, statusCode), err)
 	}
 	var dataONTAP NameServicesDNSGetDataModelONTAP
 	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
@@ -111,9 +112,9 @@ func CreateNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestCl
 
 // DeleteNameServicesDNS deletes a DNS
 func DeleteNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
-	statusCode, _, err := r.CallDeleteMethod("name-services/dns"+uuid, nil, nil)
+	statusCode, _, err := r.CallDeleteMethod("name-services/dns/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting DNS", fmt.Sprintf("error on DELETE name-services/dns: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting DNS", fmt.Sprintf("error on DELETE name-services/dns: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
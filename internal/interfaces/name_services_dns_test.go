@@ -67,14 +67,14 @@ func TestGetNameServicesDNS(t *testing.T) {
 		want    *NameServicesDNSGetDataModelONTAP
 		wantErr bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &dnsRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_error_3", responses: responses["test_error_3"], want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -144,7 +144,7 @@ func TestGetListNameServicesDNSs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -182,7 +182,7 @@ func TestCreateNameServicesDNS(t *testing.T) {
 	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
 	responses := map[string][]restclient.MockResponse{
 		"test_one_record_1": {
-			{ExpectedMethod: "POST", ExpectedURL: "name-services/dns", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/dns", ExpectedQuery: "return_records=true&return_timeout=60", ExpectedBody: recordInterface, StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_error": {
 			{ExpectedMethod: "POST", ExpectedURL: "name-services/dns", StatusCode: 200, Response: twoRecords, Err: genericError},
@@ -204,7 +204,7 @@ func TestCreateNameServicesDNS(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -259,7 +259,7 @@ func TestDeleteNameServicesDNS(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -81,11 +81,12 @@ func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, n
 	}
 	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading ip_interface info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading ip_interface info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP IPInterfaceGetDataModelONTAP
@@ -120,7 +121,7 @@ func GetListIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClie
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading ip_interfaces info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading ip_interfaces info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []IPInterfaceGetDataModelONTAP
@@ -147,7 +148,7 @@ func CreateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating ip_interface", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating ip_interface", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP IPInterfaceGetDataModelONTAP
@@ -167,7 +168,7 @@ func UpdateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	}
 	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating ip_interface", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating ip_interface", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -177,7 +178,7 @@ func DeleteIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	api := "network/ip/interfaces"
 	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting ip_interface", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting ip_interface", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -118,7 +118,7 @@ func TestGetIPInterface(t *testing.T) {
 		want    *IPInterfaceGetDataModelONTAP
 		wantErr bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &ipInterfaceRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
@@ -126,7 +126,7 @@ func TestGetIPInterface(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -198,7 +198,7 @@ func TestGetListIPInterfaces(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -254,7 +254,7 @@ func TestCreateIPInterfaces(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -305,7 +305,7 @@ func TestDeleteIPInterfaces(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -344,7 +344,7 @@ func TestUpdateIPInterfaces(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
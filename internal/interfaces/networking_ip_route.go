@@ -57,11 +57,12 @@ func GetIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, Desti
 	}
 	query.Fields(fields)
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading /network/ip/routes info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading /network/ip/routes info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP IPRouteGetDataModelONTAP
@@ -94,7 +95,7 @@ func GetListIPRoutes(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading ip_route info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading ip_route info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []IPRouteGetDataModelONTAP
@@ -121,7 +122,7 @@ func CreateIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, bo
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating /network/ip/routes", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating /network/ip/routes", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP IPRouteGetDataModelONTAP
@@ -137,7 +138,7 @@ func DeleteIPRoute(errorHandler *utils.ErrorHandler, r restclient.RestClient, uu
 	api := "/network/ip/routes"
 	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting /network/ip/routes", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting /network/ip/routes", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -70,14 +70,14 @@ func TestGetIPRoute(t *testing.T) {
 		gen     int
 		maj     int
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &ipRouteRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -152,7 +152,7 @@ func TestGetListIPRoutes(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -0,0 +1,196 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsCifsServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsCifsServiceGetDataModelONTAP struct {
+	Name            string              `mapstructure:"name"`
+	Enabled         bool                `mapstructure:"enabled"`
+	Comment         string              `mapstructure:"comment"`
+	DefaultUnixUser string              `mapstructure:"default_unix_user"`
+	AdDomain        CifsServiceAdDomain `mapstructure:"ad_domain"`
+	Netbios         CifsServiceNetbios  `mapstructure:"netbios"`
+	Security        CifsServiceSecurity `mapstructure:"security"`
+	SVM             SvmDataModelONTAP   `mapstructure:"svm"`
+}
+
+// CifsServiceAdDomain describes the Active Directory domain of a CIFS server.
+// The user and password are only used to join, rejoin or leave the domain, and are never returned by ONTAP.
+type CifsServiceAdDomain struct {
+	Fqdn               string `mapstructure:"fqdn,omitempty"`
+	OrganizationalUnit string `mapstructure:"organizational_unit,omitempty"`
+	User               string `mapstructure:"user,omitempty"`
+	Password           string `mapstructure:"password,omitempty"`
+}
+
+// CifsServiceNetbios describes the NetBIOS settings of a CIFS server.
+type CifsServiceNetbios struct {
+	Aliases     []string `mapstructure:"aliases"`
+	Enabled     bool     `mapstructure:"enabled"`
+	WinsServers []string `mapstructure:"wins_servers"`
+}
+
+// CifsServiceSecurity describes the security settings of a CIFS server.
+type CifsServiceSecurity struct {
+	SmbSigning           bool   `mapstructure:"smb_signing"`
+	SmbEncryption        bool   `mapstructure:"smb_encryption"`
+	EncryptDcConnection  bool   `mapstructure:"encrypt_dc_connection"`
+	AesNetlogonEnabled   bool   `mapstructure:"aes_netlogon_enabled"`
+	RestrictAnonymous    string `mapstructure:"restrict_anonymous"`
+	LmCompatibilityLevel string `mapstructure:"lm_compatibility_level"`
+}
+
+// ProtocolsCifsServiceResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+// Pointers are used so that false and empty values are sent when they are set.
+type ProtocolsCifsServiceResourceBodyDataModelONTAP struct {
+	Name            string                   `mapstructure:"name,omitempty"`
+	SVM             *SvmDataModelONTAP       `mapstructure:"svm,omitempty"` // svm is not allowed in the PATCH body
+	Enabled         *bool                    `mapstructure:"enabled,omitempty"`
+	Comment         *string                  `mapstructure:"comment,omitempty"`
+	DefaultUnixUser string                   `mapstructure:"default_unix_user,omitempty"`
+	AdDomain        *CifsServiceAdDomain     `mapstructure:"ad_domain,omitempty"`
+	Netbios         *CifsServiceNetbiosBody  `mapstructure:"netbios,omitempty"`
+	Security        *CifsServiceSecurityBody `mapstructure:"security,omitempty"`
+}
+
+// CifsServiceNetbiosBody describes the NetBIOS settings for POST and PATCH.
+// aliases and wins_servers are always sent, so that they can be emptied.
+type CifsServiceNetbiosBody struct {
+	Aliases     []string `mapstructure:"aliases"`
+	Enabled     *bool    `mapstructure:"enabled,omitempty"`
+	WinsServers []string `mapstructure:"wins_servers"`
+}
+
+// CifsServiceSecurityBody describes the security settings for POST and PATCH.
+type CifsServiceSecurityBody struct {
+	SmbSigning           *bool  `mapstructure:"smb_signing,omitempty"`
+	SmbEncryption        *bool  `mapstructure:"smb_encryption,omitempty"`
+	EncryptDcConnection  *bool  `mapstructure:"encrypt_dc_connection,omitempty"`
+	AesNetlogonEnabled   *bool  `mapstructure:"aes_netlogon_enabled,omitempty"`
+	RestrictAnonymous    string `mapstructure:"restrict_anonymous,omitempty"`
+	LmCompatibilityLevel string `mapstructure:"lm_compatibility_level,omitempty"`
+}
+
+// CifsServicesFilterModel describes filter model
+type CifsServicesFilterModel struct {
+	SVMName string `mapstructure:"svm.name,omitempty"`
+	Name    string `mapstructure:"name,omitempty"`
+	Domain  string `mapstructure:"ad_domain.fqdn,omitempty"`
+}
+
+// cifsServiceFields are requested as whole objects, so that only the fields supported by the ONTAP version are returned
+var cifsServiceFields = []string{"svm.name", "svm.uuid", "name", "enabled", "comment", "default_unix_user", "ad_domain", "netbios", "security"}
+
+// GetProtocolsCifsService to get protocols_cifs_service info
+func GetProtocolsCifsService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsCifsServiceGetDataModelONTAP, error) {
+	api := "protocols/cifs/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields(cifsServiceFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_cifs_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP ProtocolsCifsServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_service data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsCifsServices to get protocols_cifs_services info
+func GetProtocolsCifsServices(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *CifsServicesFilterModel) ([]ProtocolsCifsServiceGetDataModelONTAP, error) {
+	api := "protocols/cifs/services"
+	query := r.NewQuery()
+	query.Fields(cifsServiceFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_cifs_service filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_cifs_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP []ProtocolsCifsServiceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsCifsServiceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_service data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsCifsService creates a CIFS server, and joins it to the Active Directory domain.
+// The job is waited for, the server is not returned as ONTAP does not return records for this call.
+func CreateProtocolsCifsService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsCifsServiceResourceBodyDataModelONTAP) error {
+	api := "protocols/cifs/services"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding CIFS service body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error creating CIFS service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateProtocolsCifsService updates a CIFS server.  The Active Directory credentials are required to rename the server,
+// or to change its domain or organizational unit.
+func UpdateProtocolsCifsService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsCifsServiceResourceBodyDataModelONTAP, svmUUID string) error {
+	api := "protocols/cifs/services/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding CIFS service body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating CIFS service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteProtocolsCifsService deletes a CIFS server.  With the Active Directory credentials, the machine account is removed from the domain.
+func DeleteProtocolsCifsService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, adDomain CifsServiceAdDomain) error {
+	api := "protocols/cifs/services/" + svmUUID
+	var body map[string]interface{}
+	if adDomain.User != "" {
+		body = map[string]interface{}{
+			"ad_domain": map[string]interface{}{
+				"user":     adDomain.User,
+				"password": adDomain.Password,
+			},
+		}
+	}
+	statusCode, _, err := r.CallDeleteMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting CIFS service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var cifsServiceRecord = ProtocolsCifsServiceGetDataModelONTAP{
+	Name:            "CIFS1",
+	Enabled:         true,
+	Comment:         "managed by terraform",
+	DefaultUnixUser: "pcuser",
+	AdDomain: CifsServiceAdDomain{
+		Fqdn:               "EXAMPLE.COM",
+		OrganizationalUnit: "CN=Computers",
+	},
+	Netbios: CifsServiceNetbios{
+		Aliases:     []string{"ALIAS1"},
+		Enabled:     false,
+		WinsServers: []string{"10.10.10.10"},
+	},
+	Security: CifsServiceSecurity{
+		SmbSigning:           true,
+		SmbEncryption:        false,
+		RestrictAnonymous:    "no_enumeration",
+		LmCompatibilityLevel: "lm_ntlm_ntlmv2_krb",
+	},
+	SVM: SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetProtocolsCifsService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Enabled int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsServiceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := "fields=svm.name,svm.uuid,name,enabled,comment,default_unix_user,ad_domain,netbios,security&svm.name=svm1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsCifsServiceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &cifsServiceRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCifsService(errorHandler, *r, "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCifsService() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCifsService() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProtocolsCifsServices(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsServiceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services",
+				ExpectedQuery: "fields=svm.name,svm.uuid,name,enabled,comment,default_unix_user,ad_domain,netbios,security&ad_domain.fqdn=EXAMPLE.COM",
+				StatusCode:    200, Response: twoRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/services", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		filter    *CifsServicesFilterModel
+		want      []ProtocolsCifsServiceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], filter: nil, want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], filter: &CifsServicesFilterModel{Domain: "EXAMPLE.COM"},
+			want: []ProtocolsCifsServiceGetDataModelONTAP{cifsServiceRecord, cifsServiceRecord}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], filter: nil, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCifsServices(errorHandler, *r, tt.filter)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCifsServices() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCifsServices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsCifsService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	disabled := false
+	body := ProtocolsCifsServiceResourceBodyDataModelONTAP{
+		Name: "CIFS1",
+		SVM:  &SvmDataModelONTAP{Name: "svm1"},
+		AdDomain: &CifsServiceAdDomain{
+			Fqdn:     "example.com",
+			User:     "administrator",
+			Password: "secret",
+		},
+		Netbios:  &CifsServiceNetbiosBody{Aliases: []string{}, WinsServers: []string{}},
+		Security: &CifsServiceSecurityBody{SmbEncryption: &disabled},
+	}
+	expectedBody := map[string]any{
+		"name": "CIFS1",
+		"svm":  map[string]any{"name": "svm1"},
+		"ad_domain": map[string]any{
+			"fqdn":     "example.com",
+			"user":     "administrator",
+			"password": "secret",
+		},
+		"netbios":  map[string]any{"aliases": []any{}, "wins_servers": []any{}},
+		"security": map[string]any{"smb_encryption": false},
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/cifs/services", ExpectedQuery: "return_timeout=60", ExpectedBody: expectedBody, StatusCode: 202, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/cifs/services", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateProtocolsCifsService(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsCifsService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateProtocolsCifsService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	enabled := false
+	comment := ""
+	body := ProtocolsCifsServiceResourceBodyDataModelONTAP{
+		Enabled: &enabled,
+		Comment: &comment,
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/services/1234", ExpectedBody: map[string]any{"enabled": false, "comment": ""}, StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/services/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateProtocolsCifsService(errorHandler, *r, body, "1234")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProtocolsCifsService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteProtocolsCifsService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_unjoin": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/services/1234",
+				ExpectedBody: map[string]any{"ad_domain": map[string]any{"user": "administrator", "password": "secret"}},
+				StatusCode:   200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_no_credentials": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/services/1234", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/services/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		adDomain  CifsServiceAdDomain
+		wantErr   bool
+	}{
+		{name: "test_unjoin", responses: responses["test_unjoin"], adDomain: CifsServiceAdDomain{Fqdn: "example.com", User: "administrator", Password: "secret"}, wantErr: false},
+		{name: "test_no_credentials", responses: responses["test_no_credentials"], adDomain: CifsServiceAdDomain{}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], adDomain: CifsServiceAdDomain{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteProtocolsCifsService(errorHandler, *r, "1234", tt.adDomain)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteProtocolsCifsService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
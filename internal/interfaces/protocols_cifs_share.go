@@ -0,0 +1,147 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsCifsShareGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsCifsShareGetDataModelONTAP struct {
+	Name                   string            `mapstructure:"name"`
+	Path                   string            `mapstructure:"path"`
+	Comment                string            `mapstructure:"comment"`
+	ContinuouslyAvailable  bool              `mapstructure:"continuously_available"`
+	Oplocks                bool              `mapstructure:"oplocks"`
+	AccessBasedEnumeration bool              `mapstructure:"access_based_enumeration"`
+	Encryption             bool              `mapstructure:"encryption"`
+	SVM                    SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// ProtocolsCifsShareResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+// Pointers are used so that false and empty values are sent when they are set.
+type ProtocolsCifsShareResourceBodyDataModelONTAP struct {
+	Name                   string             `mapstructure:"name,omitempty"`
+	SVM                    *SvmDataModelONTAP `mapstructure:"svm,omitempty"` // name and svm are not allowed in the PATCH body
+	Path                   string             `mapstructure:"path,omitempty"`
+	Comment                *string            `mapstructure:"comment,omitempty"`
+	ContinuouslyAvailable  *bool              `mapstructure:"continuously_available,omitempty"`
+	Oplocks                *bool              `mapstructure:"oplocks,omitempty"`
+	AccessBasedEnumeration *bool              `mapstructure:"access_based_enumeration,omitempty"`
+	Encryption             *bool              `mapstructure:"encryption,omitempty"`
+}
+
+// CifsSharesFilterModel describes filter model
+type CifsSharesFilterModel struct {
+	Name    string `mapstructure:"name,omitempty"`
+	SVMName string `mapstructure:"svm.name,omitempty"`
+	Path    string `mapstructure:"path,omitempty"`
+}
+
+var cifsShareFields = []string{"svm.name", "svm.uuid", "name", "path", "comment", "continuously_available", "oplocks", "access_based_enumeration", "encryption"}
+
+// cifsShareAPI returns the path of a share.  The share name is escaped, as it may include spaces or a $ sign.
+func cifsShareAPI(svmUUID string, name string) string {
+	return "protocols/cifs/shares/" + svmUUID + "/" + url.PathEscape(name)
+}
+
+// GetProtocolsCifsShareByName to get protocols_cifs_share info
+func GetProtocolsCifsShareByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, name string) (*ProtocolsCifsShareGetDataModelONTAP, error) {
+	api := "protocols/cifs/shares"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("name", name)
+	query.Fields(cifsShareFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_cifs_share info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP ProtocolsCifsShareGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_share data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsCifsShares to get protocols_cifs_shares info
+func GetProtocolsCifsShares(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *CifsSharesFilterModel) ([]ProtocolsCifsShareGetDataModelONTAP, error) {
+	api := "protocols/cifs/shares"
+	query := r.NewQuery()
+	query.Fields(cifsShareFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_cifs_share filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_cifs_share info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP []ProtocolsCifsShareGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsCifsShareGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_share data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsCifsShare creates a CIFS share.  ONTAP adds an ACL granting full control to Everyone.
+func CreateProtocolsCifsShare(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsCifsShareResourceBodyDataModelONTAP) error {
+	api := "protocols/cifs/shares"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding CIFS share body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error creating CIFS share", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateProtocolsCifsShare updates a CIFS share
+func UpdateProtocolsCifsShare(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsCifsShareResourceBodyDataModelONTAP, svmUUID string, name string) error {
+	api := cifsShareAPI(svmUUID, name)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding CIFS share body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating CIFS share", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteProtocolsCifsShare deletes a CIFS share
+func DeleteProtocolsCifsShare(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := cifsShareAPI(svmUUID, name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting CIFS share", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
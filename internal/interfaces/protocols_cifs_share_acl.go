@@ -0,0 +1,143 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsCifsShareACLGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsCifsShareACLGetDataModelONTAP struct {
+	UserOrGroup string            `mapstructure:"user_or_group"`
+	Type        string            `mapstructure:"type"`
+	Permission  string            `mapstructure:"permission"`
+	Share       string            `mapstructure:"share"`
+	SVM         SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// ProtocolsCifsShareACLResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+type ProtocolsCifsShareACLResourceBodyDataModelONTAP struct {
+	UserOrGroup string `mapstructure:"user_or_group,omitempty"` // user_or_group and type are not allowed in the PATCH body
+	Type        string `mapstructure:"type,omitempty"`
+	Permission  string `mapstructure:"permission"`
+}
+
+// CifsShareACLsFilterModel describes filter model
+type CifsShareACLsFilterModel struct {
+	UserOrGroup string `mapstructure:"user_or_group,omitempty"`
+	Type        string `mapstructure:"type,omitempty"`
+	Permission  string `mapstructure:"permission,omitempty"`
+}
+
+var cifsShareACLFields = []string{"svm.name", "svm.uuid", "share", "user_or_group", "type", "permission"}
+
+// cifsShareACLsAPI returns the path of the ACLs of a share
+func cifsShareACLsAPI(svmUUID string, shareName string) string {
+	return cifsShareAPI(svmUUID, shareName) + "/acls"
+}
+
+// cifsShareACLAPI returns the path of an ACL.  User and group names are escaped, as they usually include a \ sign.
+func cifsShareACLAPI(svmUUID string, shareName string, userOrGroup string, aclType string) string {
+	return cifsShareACLsAPI(svmUUID, shareName) + "/" + url.PathEscape(userOrGroup) + "/" + aclType
+}
+
+// GetProtocolsCifsShareACL to get protocols_cifs_share_acl info
+func GetProtocolsCifsShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, shareName string, userOrGroup string, aclType string) (*ProtocolsCifsShareACLGetDataModelONTAP, error) {
+	api := cifsShareACLsAPI(svmUUID, shareName)
+	query := r.NewQuery()
+	query.Set("user_or_group", userOrGroup)
+	query.Set("type", aclType)
+	query.Fields(cifsShareACLFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_cifs_share_acl info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP ProtocolsCifsShareACLGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_share_acl data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsCifsShareACLs to get protocols_cifs_share_acls info
+func GetProtocolsCifsShareACLs(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, shareName string, filter *CifsShareACLsFilterModel) ([]ProtocolsCifsShareACLGetDataModelONTAP, error) {
+	api := cifsShareACLsAPI(svmUUID, shareName)
+	query := r.NewQuery()
+	query.Fields(cifsShareACLFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_cifs_share_acl filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_cifs_share_acl info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP []ProtocolsCifsShareACLGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsCifsShareACLGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_share_acl data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsCifsShareACL creates an ACL on a CIFS share
+func CreateProtocolsCifsShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsCifsShareACLResourceBodyDataModelONTAP, svmUUID string, shareName string) error {
+	api := cifsShareACLsAPI(svmUUID, shareName)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding CIFS share ACL body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error creating CIFS share ACL", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateProtocolsCifsShareACL updates the permission of an ACL on a CIFS share
+func UpdateProtocolsCifsShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, permission string, svmUUID string, shareName string, userOrGroup string, aclType string) error {
+	api := cifsShareACLAPI(svmUUID, shareName, userOrGroup, aclType)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(ProtocolsCifsShareACLResourceBodyDataModelONTAP{Permission: permission}, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding CIFS share ACL body", fmt.Sprintf("error on encoding %s body: %s, permission: %s", api, err, permission))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating CIFS share ACL", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteProtocolsCifsShareACL deletes an ACL on a CIFS share
+func DeleteProtocolsCifsShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, shareName string, userOrGroup string, aclType string) error {
+	api := cifsShareACLAPI(svmUUID, shareName, userOrGroup, aclType)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting CIFS share ACL", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var cifsShareACLRecord = ProtocolsCifsShareACLGetDataModelONTAP{
+	UserOrGroup: `EXAMPLE\admins`,
+	Type:        "windows",
+	Permission:  "full_control",
+	Share:       "share1",
+	SVM:         SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetProtocolsCifsShareACL(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Permission int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsShareACLRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := `fields=svm.name,svm.uuid,share,user_or_group,type,permission&user_or_group=EXAMPLE\admins&type=windows`
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsCifsShareACLGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &cifsShareACLRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCifsShareACL(errorHandler, *r, "1234", "share1", `EXAMPLE\admins`, "windows")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCifsShareACL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCifsShareACL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProtocolsCifsShareACLs(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsShareACLRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls",
+				ExpectedQuery: "fields=svm.name,svm.uuid,share,user_or_group,type,permission&permission=full_control",
+				StatusCode:    200, Response: twoRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		filter    *CifsShareACLsFilterModel
+		want      []ProtocolsCifsShareACLGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], filter: nil, want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], filter: &CifsShareACLsFilterModel{Permission: "full_control"},
+			want: []ProtocolsCifsShareACLGetDataModelONTAP{cifsShareACLRecord, cifsShareACLRecord}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], filter: nil, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCifsShareACLs(errorHandler, *r, "1234", "share1", tt.filter)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCifsShareACLs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCifsShareACLs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsCifsShareACL(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	body := ProtocolsCifsShareACLResourceBodyDataModelONTAP{
+		UserOrGroup: `EXAMPLE\admins`,
+		Type:        "windows",
+		Permission:  "change",
+	}
+	expectedBody := map[string]any{
+		"user_or_group": `EXAMPLE\admins`,
+		"type":          "windows",
+		"permission":    "change",
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", ExpectedQuery: "return_timeout=60", ExpectedBody: expectedBody, StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/cifs/shares/1234/share1/acls", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateProtocolsCifsShareACL(errorHandler, *r, body, "1234", "share1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsCifsShareACL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateProtocolsCifsShareACL(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/shares/1234/share1/acls/EXAMPLE%5Cadmins/windows",
+				ExpectedBody: map[string]any{"permission": "read"},
+				StatusCode:   200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/shares/1234/share1/acls/EXAMPLE%5Cadmins/windows", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateProtocolsCifsShareACL(errorHandler, *r, "read", "1234", "share1", `EXAMPLE\admins`, "windows")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProtocolsCifsShareACL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteProtocolsCifsShareACL(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/shares/1234/share1/acls/Everyone/windows", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/shares/1234/share1/acls/Everyone/windows", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteProtocolsCifsShareACL(errorHandler, *r, "1234", "share1", "Everyone", "windows")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteProtocolsCifsShareACL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var cifsShareRecord = ProtocolsCifsShareGetDataModelONTAP{
+	Name:                   "share 1",
+	Path:                   "/vol1",
+	Comment:                "managed by terraform",
+	ContinuouslyAvailable:  false,
+	Oplocks:                true,
+	AccessBasedEnumeration: true,
+	Encryption:             false,
+	SVM:                    SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetProtocolsCifsShareByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Oplocks int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsShareRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := "fields=svm.name,svm.uuid,name,path,comment,continuously_available,oplocks,access_based_enumeration,encryption&svm.name=svm1&name=share 1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsCifsShareGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &cifsShareRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCifsShareByName(errorHandler, *r, "svm1", "share 1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCifsShareByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCifsShareByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProtocolsCifsShares(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(cifsShareRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares",
+				ExpectedQuery: "fields=svm.name,svm.uuid,name,path,comment,continuously_available,oplocks,access_based_enumeration,encryption&svm.name=svm1&path=/vol1",
+				StatusCode:    200, Response: twoRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/shares", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		filter    *CifsSharesFilterModel
+		want      []ProtocolsCifsShareGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], filter: nil, want: nil, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], filter: &CifsSharesFilterModel{SVMName: "svm1", Path: "/vol1"},
+			want: []ProtocolsCifsShareGetDataModelONTAP{cifsShareRecord, cifsShareRecord}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], filter: nil, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCifsShares(errorHandler, *r, tt.filter)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCifsShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCifsShares() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsCifsShare(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	disabled := false
+	body := ProtocolsCifsShareResourceBodyDataModelONTAP{
+		Name:    "share 1",
+		SVM:     &SvmDataModelONTAP{Name: "svm1"},
+		Path:    "/vol1",
+		Oplocks: &disabled,
+	}
+	expectedBody := map[string]any{
+		"name":    "share 1",
+		"svm":     map[string]any{"name": "svm1"},
+		"path":    "/vol1",
+		"oplocks": false,
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/cifs/shares", ExpectedQuery: "return_timeout=60", ExpectedBody: expectedBody, StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/cifs/shares", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateProtocolsCifsShare(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsCifsShare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateProtocolsCifsShare(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	enabled := true
+	comment := ""
+	body := ProtocolsCifsShareResourceBodyDataModelONTAP{
+		Path:                  "/vol2",
+		Comment:               &comment,
+		ContinuouslyAvailable: &enabled,
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/shares/1234/share%201",
+				ExpectedBody: map[string]any{"path": "/vol2", "comment": "", "continuously_available": true},
+				StatusCode:   200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/shares/1234/share%201", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateProtocolsCifsShare(errorHandler, *r, body, "1234", "share 1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProtocolsCifsShare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteProtocolsCifsShare(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/shares/1234/share%201", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/cifs/shares/1234/share%201", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteProtocolsCifsShare(errorHandler, *r, "1234", "share 1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteProtocolsCifsShare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -39,7 +39,7 @@ func CreateExportPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClien
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod("protocols/nfs/export-policies", query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating export policy", fmt.Sprintf("error on POST protocols/nfs/export-policies: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating export policy", fmt.Sprintf("error on POST protocols/nfs/export-policies: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP ExportPolicyGetDataModelONTAP
@@ -54,11 +54,12 @@ func CreateExportPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClien
 func GetExportPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string) (*ExportPolicyGetDataModelONTAP, error) {
 	api := "protocols/nfs/export-policies/" + id
 	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading export policy info", fmt.Sprintf("error on GET protocols/nfs/export-policies/%s: %s", id, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading export policy info", fmt.Sprintf("error on GET protocols/nfs/export-policies/%s: %s", id, err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP ExportPolicyGetDataModelONTAP
@@ -82,7 +83,11 @@ func GetNfsExportPolicyByName(errorHandler *utils.ErrorHandler, r restclient.Res
 	}
 	statusCode, response, err := r.GetNilOrOneRecord("protocols/nfs/export-policies", query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading export policy info", fmt.Sprintf("error on GET protocols/nfs/export-policies: %s", err))
+		return nil, errorHandler.MakeAndReportRestError("error reading export policy info", fmt.Sprintf("error on GET protocols/nfs/export-policies: %s", err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no export policy found for filter %#v", filter))
+		return nil, nil
 	}
 
 	var dataONTAP ExportPolicyGetDataModelONTAP
@@ -110,7 +115,7 @@ func GetExportPoliciesList(errorHandler *utils.ErrorHandler, r restclient.RestCl
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading export policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading export policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []ExportpolicyResourceModel
@@ -130,7 +135,7 @@ func GetExportPoliciesList(errorHandler *utils.ErrorHandler, r restclient.RestCl
 func DeleteExportPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string) error {
 	statusCode, _, err := r.CallDeleteMethod("protocols/nfs/export-policies/"+id, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting export policy", fmt.Sprintf("error on DELETE protocols/nfs/export-policies/%s: %s, statusCode %d", id, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting export policy", fmt.Sprintf("error on DELETE protocols/nfs/export-policies/%s: %s, statusCode %d", id, err, statusCode), err)
 	}
 	return nil
 }
@@ -147,7 +152,7 @@ func UpdateExportPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClien
 	query.Add("return_records", "true")
 	statusCode, _, err := r.CallUpdateMethod("protocols/nfs/export-policies/"+id, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error creating export policy", fmt.Sprintf("error on POST protocols/nfs/export-policies: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error creating export policy", fmt.Sprintf("error on POST protocols/nfs/export-policies: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
@@ -80,7 +80,7 @@ func CreateExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestC
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(fmt.Sprintf("protocols/nfs/export-policies/%s/rules", exportPolicyID), query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating export policy rule", fmt.Sprintf("error on POST protocols/nfs/export-policies/%s/rules: %s, statusCode %d", exportPolicyID, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating export policy rule", fmt.Sprintf("error on POST protocols/nfs/export-policies/%s/rules: %s, statusCode %d", exportPolicyID, err, statusCode), err)
 	}
 
 	var dataONTAP ExportPolicyRuleGetDataModelONTAP
@@ -95,11 +95,12 @@ func CreateExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestC
 func GetExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestClient, exportPolicyID string, index int64) (*ExportPolicyRuleGetDataModelONTAP, error) {
 	api := "protocols/nfs/export-policies/" + exportPolicyID + "/rules/" + strconv.FormatInt(index, 10)
 	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading export policy rule info", fmt.Sprintf("error on GET protocols/nfs/export-policies/%s/rules/%d: %s", exportPolicyID, index, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading export policy rule info", fmt.Sprintf("error on GET protocols/nfs/export-policies/%s/rules/%d: %s", exportPolicyID, index, err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP ExportPolicyRuleGetDataModelONTAP
@@ -124,7 +125,7 @@ func GetExportPolicyRuleSingle(errorHandler *utils.ErrorHandler, r restclient.Re
 
 	statusCode, response, err := r.GetNilOrOneRecord("protocols/nfs/export-policies/"+exportPolicyID+"/rules/"+strconv.FormatInt(index, 10), query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading export policy rule info", fmt.Sprintf("error on GET protocols/nfs/export-policies/%s/rules/%d: %s", exportPolicyID, index, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading export policy rule info", fmt.Sprintf("error on GET protocols/nfs/export-policies/%s/rules/%d: %s", exportPolicyID, index, err), err)
 	}
 
 	var dataONTAP *ExportPolicyRuleGetDataModelONTAP
@@ -159,7 +160,7 @@ func GetListExportPolicyRules(errorHandler *utils.ErrorHandler, r restclient.Res
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading protocols_nfs_export_policy_rule info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_nfs_export_policy_rule info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []ExportPolicyRuleGetDataModelONTAP
@@ -185,7 +186,7 @@ func UpdateExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestC
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Update export policy source rule - body data: %#v", data))
 	statusCode, response, err := r.CallUpdateMethod(fmt.Sprintf("protocols/nfs/export-policies/%s/rules/%d", exportPolicyID, index), nil, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error updating export policy rule", fmt.Sprintf("error on PATCH protocols/nfs/export-policies/%s/rules/%d: %s, statusCode %d", exportPolicyID, index, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error updating export policy rule", fmt.Sprintf("error on PATCH protocols/nfs/export-policies/%s/rules/%d: %s, statusCode %d", exportPolicyID, index, err, statusCode), err)
 	}
 
 	var dataONTAP ExportPolicyRuleGetDataModelONTAP
@@ -200,7 +201,7 @@ func UpdateExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestC
 func DeleteExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestClient, exportPolicyID string, index int64) error {
 	statusCode, _, err := r.CallDeleteMethod("protocols/nfs/export-policies/"+exportPolicyID+"/rules/"+strconv.FormatInt(index, 10), nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting export policy rule", fmt.Sprintf("error on DELETE protocols/nfs/export-policies/%s/rules/%d: %s, statusCode %d", exportPolicyID, index, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting export policy rule", fmt.Sprintf("error on DELETE protocols/nfs/export-policies/%s/rules/%d: %s, statusCode %d", exportPolicyID, index, err, statusCode), err)
 	}
 	return nil
 }
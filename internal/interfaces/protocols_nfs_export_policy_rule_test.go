@@ -141,11 +141,11 @@ func TestGetExportPolicyRuleSingle(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetExportPolicyRuleSingle(errorHandler, *r, "string", 8, versionModelONTAP{Generation: 9, Major: 10})
+			got, err := GetExportPolicyRuleSingle(errorHandler, *r, "12884901889", 8, versionModelONTAP{Generation: 9, Major: 10})
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -199,18 +199,18 @@ func TestGetExportPolicyRule(t *testing.T) {
 		want      *ExportPolicyRuleGetDataModelONTAP
 		wantErr   bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &basicExportPolicyRuleRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_get_error_1", responses: responses["test_get_error_1"], want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetExportPolicyRule(errorHandler, *r, "string", 8)
+			got, err := GetExportPolicyRule(errorHandler, *r, "12884901889", 8)
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -278,11 +278,11 @@ func TestGetListExportPolicyRules(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetListExportPolicyRules(errorHandler, *r, "string", nil, versionModelONTAP{Generation: tt.gen, Major: tt.maj})
+			got, err := GetListExportPolicyRules(errorHandler, *r, "1234", nil, versionModelONTAP{Generation: tt.gen, Major: tt.maj})
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -332,7 +332,7 @@ func TestCreateExportPolicyRule(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -383,7 +383,7 @@ func TestDeleteSnapshotPolicyRule(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -422,7 +422,7 @@ func TestUpdateExportPolicyRule(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
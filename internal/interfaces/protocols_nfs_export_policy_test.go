@@ -78,16 +78,16 @@ func TestGetExportPolicy(t *testing.T) {
 	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
 	responses := map[string][]restclient.MockResponse{
 		"test_no_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_one_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_error": {
-			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: twoRecords, Err: genericError},
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: twoRecords, Err: genericError},
 		},
 		"test_get_error_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: decodeError, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: decodeError, Err: nil},
 		},
 	}
 	tests := []struct {
@@ -96,14 +96,14 @@ func TestGetExportPolicy(t *testing.T) {
 		want      *ExportPolicyGetDataModelONTAP
 		wantErr   bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &basicExportPolicyRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_get_error_1", responses: responses["test_get_error_1"], want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -157,7 +157,7 @@ func TestCreateExportPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -182,10 +182,10 @@ func TestDeleteExportPolicy(t *testing.T) {
 	genericError := errors.New("generic error for UT")
 	responses := map[string][]restclient.MockResponse{
 		"test_delete_1": {
-			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_delete_error_1": {
-			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: noRecords, Err: genericError},
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
 	}
 	tests := []struct {
@@ -198,7 +198,7 @@ func TestDeleteExportPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -220,10 +220,10 @@ func TestUpdateExportPolicy(t *testing.T) {
 	genericError := errors.New("generic error for UT")
 	responses := map[string][]restclient.MockResponse{
 		"test_update_rename_export_policy": {
-			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_update_error_1": {
-			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies", StatusCode: 200, Response: noRecords, Err: genericError},
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies/string", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
 	}
 	tests := []struct {
@@ -237,7 +237,7 @@ func TestUpdateExportPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -304,7 +304,7 @@ func TestGetExportPoliciesList(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -98,11 +98,12 @@ func GetProtocolsNfsService(errorHandler *utils.ErrorHandler, r restclient.RestC
 	query.Fields(fields)
 
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading protcols_nfs_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading protcols_nfs_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP ProtocolsNfsServiceGetDataModelONTAP
@@ -142,7 +143,7 @@ func GetProtocolsNfsServices(errorHandler *utils.ErrorHandler, r restclient.Rest
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading protocols_nfs_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_nfs_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []ProtocolsNfsServiceGetDataModelONTAP
@@ -168,7 +169,7 @@ func CreateProtocolsNfsService(errorHandler *utils.ErrorHandler, r restclient.Re
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod("protocols/nfs/services", query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating NFS services", fmt.Sprintf("error on POST protocols/nfs/services: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating NFS services", fmt.Sprintf("error on POST protocols/nfs/services: %s, statusCode %d", err, statusCode), err)
 	}
 	var dataONTAP ProtocolsNfsServiceGetDataModelONTAP
 	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
@@ -182,7 +183,7 @@ func CreateProtocolsNfsService(errorHandler *utils.ErrorHandler, r restclient.Re
 func DeleteProtocolsNfsService(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
 	statusCode, _, err := r.CallDeleteMethod("protocols/nfs/services/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting NFS Service", fmt.Sprintf("error on DELETE protocols/nfs/services: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting NFS Service", fmt.Sprintf("error on DELETE protocols/nfs/services: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
@@ -197,7 +198,7 @@ func UpdateProtocolsNfsService(errorHandler *utils.ErrorHandler, r restclient.Re
 	query.Add("return_records", "true")
 	statusCode, _, err := r.CallUpdateMethod("protocols/nfs/services/"+uuid, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error modifying NFS Service", fmt.Sprintf("error on PATCH rotocols/nfs/services/s: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error modifying NFS Service", fmt.Sprintf("error on PATCH rotocols/nfs/services/s: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
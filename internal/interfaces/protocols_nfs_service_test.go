@@ -133,7 +133,7 @@ func TestGetProtocolsNfsService(t *testing.T) {
 		gen     int
 		maj     int
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true, gen: 9, maj: 11},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false, gen: 9, maj: 11},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &nfsServiceRecord, wantErr: false, gen: 9, maj: 11},
 		{name: "test_one_910_record_1", responses: responses["test_one_910_record_1"], want: &record910, wantErr: false, gen: 9, maj: 10},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true, gen: 9, maj: 11},
@@ -141,7 +141,7 @@ func TestGetProtocolsNfsService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -211,7 +211,7 @@ func TestCreateProtocolsNfsService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -239,7 +239,7 @@ func TestDeleteProtocolsNfsService(t *testing.T) {
 			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nfs/services/1234", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_error_2": {
-			{ExpectedMethod: "GET", ExpectedURL: "protocols/nfs/services/1234", StatusCode: 200, Response: noRecords, Err: genericError},
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nfs/services/1234", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
 	}
 	tests := []struct {
@@ -254,7 +254,7 @@ func TestDeleteProtocolsNfsService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -335,7 +335,7 @@ func TestGetProtocolsNfsServices(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
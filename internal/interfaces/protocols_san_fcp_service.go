@@ -0,0 +1,135 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsSanFcpServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsSanFcpServiceGetDataModelONTAP struct {
+	Enabled bool                `mapstructure:"enabled"`
+	Target  SanFcpServiceTarget `mapstructure:"target"`
+	SVM     SvmDataModelONTAP   `mapstructure:"svm"`
+}
+
+// SanFcpServiceTarget describes the FC target of the svm, the name is the WWNN set by ONTAP
+type SanFcpServiceTarget struct {
+	Name string `mapstructure:"name"`
+}
+
+// ProtocolsSanFcpServiceResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+type ProtocolsSanFcpServiceResourceBodyDataModelONTAP struct {
+	SVM     *SvmDataModelONTAP `mapstructure:"svm,omitempty"` // svm is not allowed in the PATCH body
+	Enabled *bool              `mapstructure:"enabled,omitempty"`
+}
+
+// SanFcpServicesFilterModel describes filter model
+type SanFcpServicesFilterModel struct {
+	SVMName string `mapstructure:"svm.name,omitempty"`
+}
+
+var sanFcpServiceFields = []string{"svm.name", "svm.uuid", "enabled", "target.name"}
+
+// GetProtocolsSanFcpService to get protocols_san_fcp_service info
+func GetProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsSanFcpServiceGetDataModelONTAP, error) {
+	api := "protocols/san/fcp/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields(sanFcpServiceFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_san_fcp_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP ProtocolsSanFcpServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_fcp_service data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsSanFcpServices to get protocols_san_fcp_services info
+func GetProtocolsSanFcpServices(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *SanFcpServicesFilterModel) ([]ProtocolsSanFcpServiceGetDataModelONTAP, error) {
+	api := "protocols/san/fcp/services"
+	query := r.NewQuery()
+	query.Fields(sanFcpServiceFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_san_fcp_service filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_san_fcp_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP []ProtocolsSanFcpServiceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsSanFcpServiceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_fcp_service data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsSanFcpService creates the FCP service of a svm
+func CreateProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanFcpServiceResourceBodyDataModelONTAP) error {
+	api := "protocols/san/fcp/services"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding FCP service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error creating FCP service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateProtocolsSanFcpService updates the FCP service of a svm, to enable or disable the service
+func UpdateProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanFcpServiceResourceBodyDataModelONTAP, svmUUID string) error {
+	api := "protocols/san/fcp/services/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding FCP service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating FCP service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteProtocolsSanFcpService deletes the FCP service of a svm.  ONTAP requires the service to be disabled first.
+func DeleteProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/san/fcp/services/" + svmUUID
+	statusCode, _, err := r.CallUpdateMethod(api, nil, map[string]interface{}{"enabled": false})
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error disabling FCP service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	statusCode, _, err = r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting FCP service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
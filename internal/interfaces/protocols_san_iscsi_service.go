@@ -0,0 +1,137 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsSanIscsiServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsSanIscsiServiceGetDataModelONTAP struct {
+	Enabled bool                  `mapstructure:"enabled"`
+	Target  SanIscsiServiceTarget `mapstructure:"target"`
+	SVM     SvmDataModelONTAP     `mapstructure:"svm"`
+}
+
+// SanIscsiServiceTarget describes the iSCSI target of the svm, the name is set by ONTAP
+type SanIscsiServiceTarget struct {
+	Name  string `mapstructure:"name,omitempty"`
+	Alias string `mapstructure:"alias,omitempty"`
+}
+
+// ProtocolsSanIscsiServiceResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+type ProtocolsSanIscsiServiceResourceBodyDataModelONTAP struct {
+	SVM     *SvmDataModelONTAP     `mapstructure:"svm,omitempty"` // svm is not allowed in the PATCH body
+	Enabled *bool                  `mapstructure:"enabled,omitempty"`
+	Target  *SanIscsiServiceTarget `mapstructure:"target,omitempty"`
+}
+
+// SanIscsiServicesFilterModel describes filter model
+type SanIscsiServicesFilterModel struct {
+	SVMName string `mapstructure:"svm.name,omitempty"`
+}
+
+var sanIscsiServiceFields = []string{"svm.name", "svm.uuid", "enabled", "target.name", "target.alias"}
+
+// GetProtocolsSanIscsiService to get protocols_san_iscsi_service info
+func GetProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsSanIscsiServiceGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields(sanIscsiServiceFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_san_iscsi_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP ProtocolsSanIscsiServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_iscsi_service data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsSanIscsiServices to get protocols_san_iscsi_services info
+func GetProtocolsSanIscsiServices(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *SanIscsiServicesFilterModel) ([]ProtocolsSanIscsiServiceGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/services"
+	query := r.NewQuery()
+	query.Fields(sanIscsiServiceFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_san_iscsi_service filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading protocols_san_iscsi_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP []ProtocolsSanIscsiServiceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsSanIscsiServiceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_iscsi_service data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsSanIscsiService creates the iSCSI service of a svm
+func CreateProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanIscsiServiceResourceBodyDataModelONTAP) error {
+	api := "protocols/san/iscsi/services"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding iSCSI service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error creating iSCSI service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateProtocolsSanIscsiService updates the iSCSI service of a svm, to change the target alias, or to enable or disable the service
+func UpdateProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanIscsiServiceResourceBodyDataModelONTAP, svmUUID string) error {
+	api := "protocols/san/iscsi/services/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding iSCSI service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating iSCSI service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteProtocolsSanIscsiService deletes the iSCSI service of a svm.  ONTAP requires the service to be disabled first.
+func DeleteProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/san/iscsi/services/" + svmUUID
+	statusCode, _, err := r.CallUpdateMethod(api, nil, map[string]interface{}{"enabled": false})
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error disabling iSCSI service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	statusCode, _, err = r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting iSCSI service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var sanIscsiServiceRecord = ProtocolsSanIscsiServiceGetDataModelONTAP{
+	Enabled: true,
+	Target:  SanIscsiServiceTarget{Name: "iqn.1992-08.com.netapp:sn.1234:vs.3", Alias: "svm1"},
+	SVM:     SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetProtocolsSanIscsiService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Enabled int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(sanIscsiServiceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := "fields=svm.name,svm.uuid,enabled,target.name,target.alias&svm.name=svm1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsSanIscsiServiceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &sanIscsiServiceRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsSanIscsiService(errorHandler, *r, "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsSanIscsiService() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsSanIscsiService() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProtocolsSanIscsiServices(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(sanIscsiServiceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", ExpectedQuery: "fields=svm.name,svm.uuid,enabled,target.name,target.alias&svm.name=svm*",
+				StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/iscsi/services", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []ProtocolsSanIscsiServiceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_two_records", responses: responses["test_two_records"], want: []ProtocolsSanIscsiServiceGetDataModelONTAP{sanIscsiServiceRecord, sanIscsiServiceRecord}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsSanIscsiServices(errorHandler, *r, &SanIscsiServicesFilterModel{SVMName: "svm*"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsSanIscsiServices() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsSanIscsiServices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsSanIscsiService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	enabled := true
+	body := ProtocolsSanIscsiServiceResourceBodyDataModelONTAP{
+		SVM:     &SvmDataModelONTAP{Name: "svm1"},
+		Enabled: &enabled,
+		Target:  &SanIscsiServiceTarget{Alias: "alias1"},
+	}
+	expectedBody := map[string]any{"svm": map[string]any{"name": "svm1"}, "enabled": true, "target": map[string]any{"alias": "alias1"}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/iscsi/services", ExpectedBody: expectedBody, StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/iscsi/services", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateProtocolsSanIscsiService(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsSanIscsiService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateProtocolsSanIscsiService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	enabled := false
+	body := ProtocolsSanIscsiServiceResourceBodyDataModelONTAP{
+		Enabled: &enabled,
+		Target:  &SanIscsiServiceTarget{Alias: "alias2"},
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/services/1234", ExpectedBody: map[string]any{"enabled": false, "target": map[string]any{"alias": "alias2"}},
+				StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/services/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateProtocolsSanIscsiService(errorHandler, *r, body, "1234")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProtocolsSanIscsiService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteProtocolsSanIscsiService(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	disable := restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/services/1234", ExpectedBody: map[string]any{"enabled": false},
+		StatusCode: 200, Response: restclient.RestResponse{}, Err: nil}
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			disable,
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/iscsi/services/1234", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_disable_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/services/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+		"test_delete_error": {
+			disable,
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/iscsi/services/1234", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_disable_error", responses: responses["test_disable_error"], wantErr: true},
+		{name: "test_delete_error", responses: responses["test_delete_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteProtocolsSanIscsiService(errorHandler, *r, "1234")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteProtocolsSanIscsiService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
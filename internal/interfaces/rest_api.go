@@ -0,0 +1,222 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NormalizeRestAPIPath removes the /api/ prefix, as the REST client adds it
+func NormalizeRestAPIPath(api string) string {
+	api = strings.TrimPrefix(api, "/")
+	api = strings.TrimPrefix(api, "api/")
+	return strings.TrimSuffix(api, "/")
+}
+
+// GetRestAPIRecords reads all the records of any ONTAP collection, following the next links
+func GetRestAPIRecords(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, queryParameters map[string]string, fields []string) ([]map[string]interface{}, error) {
+	api = NormalizeRestAPIPath(api)
+	query := r.NewQuery()
+	for key, value := range queryParameters {
+		query.Set(key, value)
+	}
+	if len(fields) > 0 {
+		query.Fields(fields)
+	}
+
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError(fmt.Sprintf("error reading %s", api), fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		response = []map[string]interface{}{}
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read %s records: %d", api, len(response)))
+	return response, nil
+}
+
+// FlattenRestAPIRecord converts a record to a map of strings.  Nested objects use dot separated keys, eg svm.name,
+// lists are JSON encoded, and null values and _links are omitted.
+func FlattenRestAPIRecord(record map[string]interface{}) map[string]string {
+	flattened := map[string]string{}
+	flattenRestAPIValue("", record, flattened)
+	return flattened
+}
+
+func flattenRestAPIValue(key string, value interface{}, flattened map[string]string) {
+	switch typed := value.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		for subKey, subValue := range typed {
+			if subKey == "_links" {
+				continue
+			}
+			if key != "" {
+				subKey = key + "." + subKey
+			}
+			flattenRestAPIValue(subKey, subValue, flattened)
+		}
+	case string:
+		flattened[key] = typed
+	case bool:
+		flattened[key] = strconv.FormatBool(typed)
+	case float64:
+		flattened[key] = strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		content, err := json.Marshal(typed)
+		if err != nil {
+			flattened[key] = fmt.Sprintf("%v", typed)
+			return
+		}
+		flattened[key] = string(content)
+	}
+}
+
+// restAPIPathField matches the {field} placeholders of a path template
+var restAPIPathField = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// GetRestAPIObject reads the record matching keyQuery in a collection.  nil is returned if there is no match.
+func GetRestAPIObject(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, keyQuery map[string]string, fields []string) (map[string]interface{}, error) {
+	api = NormalizeRestAPIPath(api)
+	query := r.NewQuery()
+	for key, value := range keyQuery {
+		query.Set(key, value)
+	}
+	if len(fields) > 0 {
+		query.Fields(fields)
+	}
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError(fmt.Sprintf("error reading %s", api), fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+	return response, nil
+}
+
+// CreateRestAPIObject creates an object in a collection, and waits for the job to complete
+func CreateRestAPIObject(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, body map[string]interface{}) error {
+	api = NormalizeRestAPIPath(api)
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError(fmt.Sprintf("error creating %s", api), fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateRestAPIObject updates an object, and waits for the job to complete
+func UpdateRestAPIObject(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, body map[string]interface{}) error {
+	api = NormalizeRestAPIPath(api)
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError(fmt.Sprintf("error updating %s", api), fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteRestAPIObject deletes an object, and waits for the job to complete
+func DeleteRestAPIObject(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string) error {
+	api = NormalizeRestAPIPath(api)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError(fmt.Sprintf("error deleting %s", api), fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// RestAPIPathFields returns the fields used as placeholders in a path template, eg volume.uuid and id for storage/qtrees/{volume.uuid}/{id}
+func RestAPIPathFields(template string) []string {
+	var fields []string
+	for _, match := range restAPIPathField.FindAllStringSubmatch(template, -1) {
+		fields = append(fields, match[1])
+	}
+	return fields
+}
+
+// ResolveRestAPIPath replaces each placeholder in a path template with the value of the field in record
+func ResolveRestAPIPath(template string, record map[string]interface{}) (string, error) {
+	flattened := FlattenRestAPIRecord(record)
+	var missing []string
+	resolved := restAPIPathField.ReplaceAllStringFunc(template, func(placeholder string) string {
+		field := placeholder[1 : len(placeholder)-1]
+		value, ok := flattened[field]
+		if !ok {
+			missing = append(missing, field)
+			return placeholder
+		}
+		return url.PathEscape(value)
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("fields %s used in %s are not found in record %v", strings.Join(missing, ", "), template, flattened)
+	}
+	return NormalizeRestAPIPath(resolved), nil
+}
+
+// DecodeRestAPIBody decodes a JSON object, an empty string is decoded as nil
+func DecodeRestAPIBody(body string) (map[string]interface{}, error) {
+	if body == "" {
+		return nil, nil
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		return nil, fmt.Errorf("expecting a JSON object: %s", err)
+	}
+	return decoded, nil
+}
+
+// RestAPIDrift returns the fields whose value in record differs from the value in expected.
+// Only the fields present in expected are compared, fields are dot separated for nested objects.
+func RestAPIDrift(expected map[string]interface{}, record map[string]interface{}, fields []string) []string {
+	expectedValues := FlattenRestAPIRecord(expected)
+	actualValues := FlattenRestAPIRecord(record)
+	var drift []string
+	for _, field := range fields {
+		expectedValue, ok := expectedValues[field]
+		if !ok {
+			continue
+		}
+		if actualValue, ok := actualValues[field]; !ok || actualValue != expectedValue {
+			drift = append(drift, field)
+		}
+	}
+	return drift
+}
+
+// SetRestAPIValue copies the value of a dot separated field from record to body.  The field is removed from body if it is not in record.
+func SetRestAPIValue(body map[string]interface{}, record map[string]interface{}, field string) {
+	keys := strings.Split(field, ".")
+	var value interface{} = record
+	for _, key := range keys {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			value = nil
+			break
+		}
+		value = object[key]
+	}
+	target := body
+	for _, key := range keys[:len(keys)-1] {
+		object, ok := target[key].(map[string]interface{})
+		if !ok {
+			object = map[string]interface{}{}
+			target[key] = object
+		}
+		target = object
+	}
+	if value == nil {
+		delete(target, keys[len(keys)-1])
+		return
+	}
+	target[keys[len(keys)-1]] = value
+}
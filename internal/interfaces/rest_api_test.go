@@ -0,0 +1,261 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var restAPIDiskRecord = map[string]interface{}{
+	"name":   "1.0.0",
+	"usable": float64(1073741824),
+	"state":  "present",
+	"node":   map[string]interface{}{"name": "node1", "_links": map[string]interface{}{"self": map[string]interface{}{"href": "/api/cluster/nodes/1234"}}},
+	"paths":  []interface{}{"a", "b"},
+	"vendor": nil,
+	"shared": true,
+}
+
+func TestGetRestAPIRecords(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	pages := [][]map[string]interface{}{{restAPIDiskRecord}, {restAPIDiskRecord}}
+	tests := []struct {
+		name      string
+		api       string
+		responses []restclient.MockResponse
+		want      []map[string]interface{}
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", api: "storage/disks", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/disks", ExpectedQuery: "fields=name,usable&state=present", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 0, Records: []map[string]interface{}{}}},
+		}, want: []map[string]interface{}{}, wantErr: false},
+		{name: "test_two_pages", api: "/api/storage/disks/", responses: restclient.NewMockedPagedResponses("storage/disks", 200, pages), want: []map[string]interface{}{restAPIDiskRecord, restAPIDiskRecord}, wantErr: false},
+		{name: "test_error_1", api: "storage/disks", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/disks", StatusCode: 500, Response: restclient.RestResponse{}, Err: genericError},
+		}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetRestAPIRecords(errorHandler, *r, tt.api, map[string]string{"state": "present"}, []string{"name", "usable"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRestAPIRecords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRestAPIRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenRestAPIRecord(t *testing.T) {
+	want := map[string]string{
+		"name":      "1.0.0",
+		"usable":    "1073741824",
+		"state":     "present",
+		"node.name": "node1",
+		"paths":     `["a","b"]`,
+		"shared":    "true",
+	}
+	if got := FlattenRestAPIRecord(restAPIDiskRecord); !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenRestAPIRecord() = %v, want %v", got, want)
+	}
+}
+
+func TestGetRestAPIObject(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	qtree := map[string]interface{}{"id": float64(1), "name": "qtree1", "volume": map[string]interface{}{"uuid": "1234"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      map[string]interface{}
+		wantErr   bool
+	}{
+		{name: "test_one_record_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees", ExpectedQuery: "fields=volume.uuid,id&name=qtree1", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]interface{}{qtree}}},
+		}, want: qtree, wantErr: false},
+		{name: "test_no_record_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees", ExpectedQuery: "fields=volume.uuid,id&name=qtree1", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 0, Records: []map[string]interface{}{}}},
+		}, want: nil, wantErr: false},
+		{name: "test_error_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "storage/qtrees", StatusCode: 500, Response: restclient.RestResponse{}, Err: genericError},
+		}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetRestAPIObject(errorHandler, *r, "storage/qtrees", map[string]string{"name": "qtree1"}, []string{"volume.uuid", "id"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRestAPIObject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRestAPIObject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateRestAPIObject(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	body := map[string]interface{}{"name": "qtree1", "volume": map[string]interface{}{"name": "vol1"}}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "storage/qtrees", ExpectedBody: body, StatusCode: 201, Response: restclient.RestResponse{}},
+		}, wantErr: false},
+		{name: "test_error_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "storage/qtrees", StatusCode: 500, Response: restclient.RestResponse{}, Err: genericError},
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = CreateRestAPIObject(errorHandler, *r, "/api/storage/qtrees", body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateRestAPIObject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateRestAPIObject(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	body := map[string]interface{}{"security_style": "ntfs"}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/qtrees/1234/1", ExpectedBody: body, StatusCode: 200, Response: restclient.RestResponse{}},
+		}, wantErr: false},
+		{name: "test_error_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/qtrees/1234/1", StatusCode: 500, Response: restclient.RestResponse{}, Err: genericError},
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateRestAPIObject(errorHandler, *r, "storage/qtrees/1234/1", body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateRestAPIObject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteRestAPIObject(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/qtrees/1234/1", StatusCode: 200, Response: restclient.RestResponse{}},
+		}, wantErr: false},
+		{name: "test_error_1", responses: []restclient.MockResponse{
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/qtrees/1234/1", StatusCode: 500, Response: restclient.RestResponse{}, Err: genericError},
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteRestAPIObject(errorHandler, *r, "storage/qtrees/1234/1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteRestAPIObject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveRestAPIPath(t *testing.T) {
+	record := map[string]interface{}{"id": float64(1), "name": "qtree 1", "volume": map[string]interface{}{"uuid": "1234"}}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{name: "test_nested_field", template: "storage/qtrees/{volume.uuid}/{id}", want: "storage/qtrees/1234/1", wantErr: false},
+		{name: "test_escaped_value", template: "/api/storage/qtrees/{name}/", want: "storage/qtrees/qtree%201", wantErr: false},
+		{name: "test_no_placeholder", template: "cluster", want: "cluster", wantErr: false},
+		{name: "test_missing_field", template: "storage/qtrees/{svm.uuid}/{id}", want: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveRestAPIPath(tt.template, record)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveRestAPIPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ResolveRestAPIPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestAPIDrift(t *testing.T) {
+	record := map[string]interface{}{"name": "qtree1", "security_style": "unix", "unix_permissions": float64(755), "volume": map[string]interface{}{"name": "vol1"}}
+	tests := []struct {
+		name     string
+		expected map[string]interface{}
+		fields   []string
+		want     []string
+		wantBody map[string]interface{}
+	}{
+		{name: "test_no_drift", expected: map[string]interface{}{"name": "qtree1", "unix_permissions": float64(755)}, fields: []string{"name", "unix_permissions"}, want: nil,
+			wantBody: map[string]interface{}{"name": "qtree1", "unix_permissions": float64(755)}},
+		{name: "test_drift", expected: map[string]interface{}{"security_style": "ntfs", "volume": map[string]interface{}{"name": "vol2"}}, fields: []string{"security_style", "volume.name"}, want: []string{"security_style", "volume.name"},
+			wantBody: map[string]interface{}{"security_style": "unix", "volume": map[string]interface{}{"name": "vol1"}}},
+		{name: "test_field_not_expected", expected: map[string]interface{}{"name": "qtree1"}, fields: []string{"security_style"}, want: nil,
+			wantBody: map[string]interface{}{"name": "qtree1"}},
+		{name: "test_field_not_in_record", expected: map[string]interface{}{"comment": "test"}, fields: []string{"comment"}, want: []string{"comment"},
+			wantBody: map[string]interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RestAPIDrift(tt.expected, record, tt.fields)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestAPIDrift() = %v, want %v", got, tt.want)
+			}
+			for _, field := range got {
+				SetRestAPIValue(tt.expected, record, field)
+			}
+			if !reflect.DeepEqual(tt.expected, tt.wantBody) {
+				t.Errorf("SetRestAPIValue() = %v, want %v", tt.expected, tt.wantBody)
+			}
+		})
+	}
+}
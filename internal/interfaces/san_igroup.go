@@ -0,0 +1,224 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SanIgroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SanIgroupGetDataModelONTAP struct {
+	Name       string               `mapstructure:"name"`
+	UUID       string               `mapstructure:"uuid"`
+	OsType     string               `mapstructure:"os_type"`
+	Protocol   string               `mapstructure:"protocol"`
+	Comment    string               `mapstructure:"comment"`
+	Initiators []SanIgroupInitiator `mapstructure:"initiators"`
+	Igroups    []SanIgroupReference `mapstructure:"igroups"`
+	SVM        SvmDataModelONTAP    `mapstructure:"svm"`
+}
+
+// SanIgroupInitiator describes an initiator of an igroup.
+// igroup is only set for the initiators inherited from a nested igroup.
+type SanIgroupInitiator struct {
+	Name    string             `mapstructure:"name"`
+	Comment string             `mapstructure:"comment"`
+	Igroup  SanIgroupReference `mapstructure:"igroup"`
+}
+
+// SanIgroupReference describes a nested igroup
+type SanIgroupReference struct {
+	Name string `mapstructure:"name"`
+	UUID string `mapstructure:"uuid"`
+}
+
+// SanIgroupResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+// initiators and igroups are only used in the POST body, they are mutually exclusive.
+type SanIgroupResourceBodyDataModelONTAP struct {
+	Name       string                   `mapstructure:"name,omitempty"`
+	SVM        *SvmDataModelONTAP       `mapstructure:"svm,omitempty"` // svm and protocol are not allowed in the PATCH body
+	OsType     string                   `mapstructure:"os_type,omitempty"`
+	Protocol   string                   `mapstructure:"protocol,omitempty"`
+	Comment    *string                  `mapstructure:"comment,omitempty"`
+	Initiators []map[string]interface{} `mapstructure:"initiators,omitempty"`
+	Igroups    []map[string]interface{} `mapstructure:"igroups,omitempty"`
+}
+
+// SanIgroupsFilterModel describes filter model
+type SanIgroupsFilterModel struct {
+	Name    string `mapstructure:"name,omitempty"`
+	SVMName string `mapstructure:"svm.name,omitempty"`
+}
+
+var sanIgroupFields = []string{"svm.name", "svm.uuid", "name", "uuid", "os_type", "protocol", "comment", "initiators.name", "initiators.comment",
+	"initiators.igroup.name", "igroups.name", "igroups.uuid"}
+
+// GetSanIgroupByName to get san_igroup info
+func GetSanIgroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, name string) (*SanIgroupGetDataModelONTAP, error) {
+	api := "protocols/san/igroups"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("name", name)
+	query.Fields(sanIgroupFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading san_igroup info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP SanIgroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read san_igroup data source: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetSanIgroups to get san_igroups info
+func GetSanIgroups(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *SanIgroupsFilterModel) ([]SanIgroupGetDataModelONTAP, error) {
+	api := "protocols/san/igroups"
+	query := r.NewQuery()
+	query.Fields(sanIgroupFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding san_igroup filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading san_igroup info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP []SanIgroupGetDataModelONTAP
+	for _, info := range response {
+		var record SanIgroupGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read san_igroup data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateSanIgroup creates an igroup, with its initiators or its nested igroups
+func CreateSanIgroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SanIgroupResourceBodyDataModelONTAP) (*SanIgroupGetDataModelONTAP, error) {
+	api := "protocols/san/igroups"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding igroup body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, body)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error creating igroup", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP SanIgroupGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding igroup info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create san_igroup source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSanIgroup updates the name, os_type or comment of an igroup
+func UpdateSanIgroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SanIgroupResourceBodyDataModelONTAP, uuid string) error {
+	api := "protocols/san/igroups/" + uuid
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding igroup body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating igroup", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteSanIgroup deletes an igroup
+func DeleteSanIgroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "protocols/san/igroups/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting igroup", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// sanIgroupInitiatorAPI returns the path of an initiator.  The name is escaped, as an iSCSI name may include a # sign.
+func sanIgroupInitiatorAPI(uuid string, name string) string {
+	return "protocols/san/igroups/" + uuid + "/initiators/" + url.PathEscape(name)
+}
+
+// AddSanIgroupInitiator adds an initiator to an igroup
+func AddSanIgroupInitiator(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, name string, comment string) error {
+	api := "protocols/san/igroups/" + uuid + "/initiators"
+	body := map[string]interface{}{"name": name}
+	if comment != "" {
+		body["comment"] = comment
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error adding igroup initiator", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// UpdateSanIgroupInitiator updates the comment of an initiator
+func UpdateSanIgroupInitiator(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, name string, comment string) error {
+	api := sanIgroupInitiatorAPI(uuid, name)
+	body := map[string]interface{}{"comment": comment}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating igroup initiator", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// RemoveSanIgroupInitiator removes an initiator from an igroup
+func RemoveSanIgroupInitiator(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, name string) error {
+	api := sanIgroupInitiatorAPI(uuid, name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error removing igroup initiator", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// AddSanIgroupNestedIgroup adds a nested igroup to an igroup
+func AddSanIgroupNestedIgroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, name string) error {
+	api := "protocols/san/igroups/" + uuid + "/igroups"
+	body := map[string]interface{}{"name": name}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error adding nested igroup", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// RemoveSanIgroupNestedIgroup removes a nested igroup from an igroup, the nested igroup is not deleted
+func RemoveSanIgroupNestedIgroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, nestedUUID string) error {
+	api := "protocols/san/igroups/" + uuid + "/igroups/" + nestedUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error removing nested igroup", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var sanIgroupRecord = SanIgroupGetDataModelONTAP{
+	Name:     "igroup1",
+	UUID:     "5678",
+	OsType:   "linux",
+	Protocol: "iscsi",
+	Comment:  "managed by terraform",
+	Initiators: []SanIgroupInitiator{
+		{Name: "iqn.1994-05.com.redhat:host1", Comment: "host1"},
+		{Name: "iqn.1994-05.com.redhat:host2"},
+	},
+	SVM: SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetSanIgroupByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Protocol int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(sanIgroupRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := "fields=svm.name,svm.uuid,name,uuid,os_type,protocol,comment,initiators.name,initiators.comment,initiators.igroup.name,igroups.name,igroups.uuid" +
+		"&svm.name=svm1&name=igroup1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SanIgroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &sanIgroupRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSanIgroupByName(errorHandler, *r, "svm1", "igroup1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSanIgroupByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSanIgroupByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSanIgroups(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Protocol int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(sanIgroupRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/igroups", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []SanIgroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_two_records", responses: responses["test_two_records"], want: []SanIgroupGetDataModelONTAP{sanIgroupRecord, sanIgroupRecord}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSanIgroups(errorHandler, *r, &SanIgroupsFilterModel{SVMName: "svm1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSanIgroups() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSanIgroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSanIgroup(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	body := SanIgroupResourceBodyDataModelONTAP{
+		Name:       "igroup1",
+		SVM:        &SvmDataModelONTAP{Name: "svm1"},
+		OsType:     "linux",
+		Protocol:   "iscsi",
+		Initiators: []map[string]interface{}{{"name": "iqn.1994-05.com.redhat:host1", "comment": "host1"}},
+	}
+	expectedBody := map[string]any{
+		"name":       "igroup1",
+		"svm":        map[string]any{"name": "svm1"},
+		"os_type":    "linux",
+		"protocol":   "iscsi",
+		"initiators": []map[string]any{{"name": "iqn.1994-05.com.redhat:host1", "comment": "host1"}},
+	}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(SanIgroupGetDataModelONTAP{Name: "igroup1", UUID: "5678"}, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/igroups", ExpectedQuery: "return_records=true&return_timeout=60", ExpectedBody: expectedBody, StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/igroups", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SanIgroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &SanIgroupGetDataModelONTAP{Name: "igroup1", UUID: "5678"}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateSanIgroup(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSanIgroup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateSanIgroup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanIgroupInitiators(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_add": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/igroups/5678/initiators", ExpectedBody: map[string]any{"name": "iqn.1994-05.com.redhat:host#1", "comment": "host1"},
+				StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/igroups/5678/initiators/iqn.1994-05.com.redhat:host%231", ExpectedBody: map[string]any{"comment": ""},
+				StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_remove": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/igroups/5678/initiators/iqn.1994-05.com.redhat:host%231", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/igroups/5678/initiators", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		call      func(r restclient.RestClient) error
+		wantErr   bool
+	}{
+		{name: "test_add", responses: responses["test_add"], call: func(r restclient.RestClient) error {
+			return AddSanIgroupInitiator(errorHandler, r, "5678", "iqn.1994-05.com.redhat:host#1", "host1")
+		}, wantErr: false},
+		{name: "test_update", responses: responses["test_update"], call: func(r restclient.RestClient) error {
+			return UpdateSanIgroupInitiator(errorHandler, r, "5678", "iqn.1994-05.com.redhat:host#1", "")
+		}, wantErr: false},
+		{name: "test_remove", responses: responses["test_remove"], call: func(r restclient.RestClient) error {
+			return RemoveSanIgroupInitiator(errorHandler, r, "5678", "iqn.1994-05.com.redhat:host#1")
+		}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], call: func(r restclient.RestClient) error {
+			return AddSanIgroupInitiator(errorHandler, r, "5678", "iqn.1994-05.com.redhat:host#1", "")
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = tt.call(*r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSanIgroupNestedIgroups(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_add": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/igroups/5678/igroups", ExpectedBody: map[string]any{"name": "igroup2"}, StatusCode: 201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_remove": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/igroups/5678/igroups/9012", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/igroups/5678/igroups/9012", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		call      func(r restclient.RestClient) error
+		wantErr   bool
+	}{
+		{name: "test_add", responses: responses["test_add"], call: func(r restclient.RestClient) error {
+			return AddSanIgroupNestedIgroup(errorHandler, r, "5678", "igroup2")
+		}, wantErr: false},
+		{name: "test_remove", responses: responses["test_remove"], call: func(r restclient.RestClient) error {
+			return RemoveSanIgroupNestedIgroup(errorHandler, r, "5678", "9012")
+		}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], call: func(r restclient.RestClient) error {
+			return RemoveSanIgroupNestedIgroup(errorHandler, r, "5678", "9012")
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = tt.call(*r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SanLunGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SanLunGetDataModelONTAP struct {
+	Name         string            `mapstructure:"name"`
+	UUID         string            `mapstructure:"uuid"`
+	OsType       string            `mapstructure:"os_type"`
+	Enabled      bool              `mapstructure:"enabled"`
+	Comment      string            `mapstructure:"comment"`
+	SerialNumber string            `mapstructure:"serial_number"`
+	Location     SanLunLocation    `mapstructure:"location"`
+	Space        SanLunSpace       `mapstructure:"space"`
+	QosPolicy    SanLunQosPolicy   `mapstructure:"qos_policy"`
+	SVM          SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// SanLunLocation describes the volume, and the name of the LUN in the volume
+type SanLunLocation struct {
+	LogicalUnit string       `mapstructure:"logical_unit,omitempty"`
+	Volume      SanLunVolume `mapstructure:"volume"`
+}
+
+// SanLunVolume describes the volume containing the LUN
+type SanLunVolume struct {
+	Name string `mapstructure:"name,omitempty"`
+}
+
+// SanLunSpace describes the size of the LUN, and whether the space is reserved in the volume
+type SanLunSpace struct {
+	Size      int64              `mapstructure:"size"`
+	Guarantee SanLunSpaceReserve `mapstructure:"guarantee"`
+}
+
+// SanLunSpaceReserve describes the space reservation of the LUN
+type SanLunSpaceReserve struct {
+	Requested bool `mapstructure:"requested"`
+	Reserved  bool `mapstructure:"reserved"`
+}
+
+// SanLunQosPolicy describes the QoS policy group of the LUN
+type SanLunQosPolicy struct {
+	Name string `mapstructure:"name,omitempty"`
+}
+
+// SanLunResourceBodyDataModelONTAP describes the body data model for POST and PATCH.
+// Pointers are used so that false and empty values are sent when they are set.
+type SanLunResourceBodyDataModelONTAP struct {
+	SVM       *SvmDataModelONTAP `mapstructure:"svm,omitempty"` // svm, name and os_type are not allowed in the PATCH body
+	Name      string             `mapstructure:"name,omitempty"`
+	OsType    string             `mapstructure:"os_type,omitempty"`
+	Space     *SanLunSpaceBody   `mapstructure:"space,omitempty"`
+	QosPolicy *SanLunQosPolicy   `mapstructure:"qos_policy,omitempty"`
+	Enabled   *bool              `mapstructure:"enabled,omitempty"`
+	Comment   *string            `mapstructure:"comment,omitempty"`
+}
+
+// SanLunSpaceBody describes the space settings for POST and PATCH
+type SanLunSpaceBody struct {
+	Size      int64                   `mapstructure:"size,omitempty"`
+	Guarantee *SanLunSpaceReserveBody `mapstructure:"guarantee,omitempty"`
+}
+
+// SanLunSpaceReserveBody describes the space reservation for POST and PATCH
+type SanLunSpaceReserveBody struct {
+	Requested *bool `mapstructure:"requested,omitempty"`
+}
+
+var sanLunFields = []string{"svm.name", "svm.uuid", "name", "uuid", "location.logical_unit", "location.volume.name", "os_type", "space.size",
+	"space.guarantee.requested", "space.guarantee.reserved", "qos_policy.name", "enabled", "comment", "serial_number"}
+
+// GetSanLunByName to get san_lun info.  name is the path of the LUN, eg /vol/vol1/lun1
+func GetSanLunByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, name string) (*SanLunGetDataModelONTAP, error) {
+	api := "storage/luns"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("name", name)
+	query.Fields(sanLunFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading san_lun info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP SanLunGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read san_lun info: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSanLun creates a LUN
+func CreateSanLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SanLunResourceBodyDataModelONTAP) (*SanLunGetDataModelONTAP, error) {
+	api := "storage/luns"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding LUN body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, body)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error creating LUN", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+
+	var dataONTAP SanLunGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding LUN info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create san_lun source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateSanLun updates a LUN, to resize it, to change its settings, or to bring it online or offline
+func UpdateSanLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SanLunResourceBodyDataModelONTAP, uuid string) error {
+	api := "storage/luns/" + uuid
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding LUN body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error updating LUN", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteSanLun deletes a LUN
+func DeleteSanLun(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "storage/luns/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting LUN", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
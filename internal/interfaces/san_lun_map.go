@@ -0,0 +1,86 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SanLunMapGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SanLunMapGetDataModelONTAP struct {
+	LogicalUnitNumber int64              `mapstructure:"logical_unit_number"`
+	Lun               SanLunMapReference `mapstructure:"lun"`
+	Igroup            SanLunMapReference `mapstructure:"igroup"`
+	SVM               SvmDataModelONTAP  `mapstructure:"svm"`
+}
+
+// SanLunMapReference describes the LUN or the igroup of a map
+type SanLunMapReference struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// SanLunMapResourceBodyDataModelONTAP describes the body data model for POST.
+// The logical unit number is allocated by ONTAP when it is not set.
+type SanLunMapResourceBodyDataModelONTAP struct {
+	SVM               SvmDataModelONTAP  `mapstructure:"svm"`
+	Lun               SanLunMapReference `mapstructure:"lun"`
+	Igroup            SanLunMapReference `mapstructure:"igroup"`
+	LogicalUnitNumber *int64             `mapstructure:"logical_unit_number,omitempty"`
+}
+
+var sanLunMapFields = []string{"svm.name", "svm.uuid", "lun.name", "lun.uuid", "igroup.name", "igroup.uuid", "logical_unit_number"}
+
+// GetSanLunMap to get san_lun_map info.  lunName is the path of the LUN, eg /vol/vol1/lun1
+func GetSanLunMap(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, lunName string, igroupName string) (*SanLunMapGetDataModelONTAP, error) {
+	api := "protocols/san/lun-maps"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("lun.name", lunName)
+	query.Set("igroup.name", igroupName)
+	query.Fields(sanLunMapFields)
+
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportRestError("error reading san_lun_map info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
+	}
+
+	var dataONTAP SanLunMapGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read san_lun_map info: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSanLunMap maps a LUN to an igroup
+func CreateSanLunMap(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SanLunMapResourceBodyDataModelONTAP) error {
+	api := "protocols/san/lun-maps"
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding LUN map body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error creating LUN map", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
+
+// DeleteSanLunMap unmaps a LUN from an igroup
+func DeleteSanLunMap(errorHandler *utils.ErrorHandler, r restclient.RestClient, lunUUID string, igroupUUID string) error {
+	api := "protocols/san/lun-maps/" + lunUUID + "/" + igroupUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportRestError("error deleting LUN map", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	return nil
+}
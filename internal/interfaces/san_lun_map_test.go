@@ -0,0 +1,168 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var sanLunMapRecord = SanLunMapGetDataModelONTAP{
+	LogicalUnitNumber: 3,
+	Lun:               SanLunMapReference{Name: "/vol/vol1/lun1", UUID: "5678"},
+	Igroup:            SanLunMapReference{Name: "igroup1", UUID: "9012"},
+	SVM:               SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetSanLunMap(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Lun int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(sanLunMapRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := "fields=svm.name,svm.uuid,lun.name,lun.uuid,igroup.name,igroup.uuid,logical_unit_number&svm.name=svm1&lun.name=/vol/vol1/lun1&igroup.name=igroup1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/lun-maps", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/lun-maps", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/lun-maps", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/lun-maps", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SanLunMapGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &sanLunMapRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSanLunMap(errorHandler, *r, "svm1", "/vol/vol1/lun1", "igroup1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSanLunMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSanLunMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSanLunMap(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	lunID := int64(0)
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create_with_id": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/lun-maps", ExpectedQuery: "return_timeout=60",
+				ExpectedBody: map[string]any{"svm": map[string]any{"name": "svm1"}, "lun": map[string]any{"name": "/vol/vol1/lun1"}, "igroup": map[string]any{"name": "igroup1"}, "logical_unit_number": 0},
+				StatusCode:   201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_create_without_id": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/lun-maps",
+				ExpectedBody: map[string]any{"svm": map[string]any{"name": "svm1"}, "lun": map[string]any{"name": "/vol/vol1/lun1"}, "igroup": map[string]any{"name": "igroup1"}},
+				StatusCode:   201, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/lun-maps", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		lunID     *int64
+		wantErr   bool
+	}{
+		{name: "test_create_with_id", responses: responses["test_create_with_id"], lunID: &lunID, wantErr: false},
+		{name: "test_create_without_id", responses: responses["test_create_without_id"], lunID: nil, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], lunID: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := SanLunMapResourceBodyDataModelONTAP{
+				SVM:               SvmDataModelONTAP{Name: "svm1"},
+				Lun:               SanLunMapReference{Name: "/vol/vol1/lun1"},
+				Igroup:            SanLunMapReference{Name: "igroup1"},
+				LogicalUnitNumber: tt.lunID,
+			}
+			err = CreateSanLunMap(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSanLunMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteSanLunMap(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/lun-maps/5678/9012", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/lun-maps/5678/9012", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteSanLunMap(errorHandler, *r, "5678", "9012")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteSanLunMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var sanLunRecord = SanLunGetDataModelONTAP{
+	Name:         "/vol/vol1/lun1",
+	UUID:         "5678",
+	OsType:       "linux",
+	Enabled:      true,
+	Comment:      "managed by terraform",
+	SerialNumber: "wCVbW$Ms7Wod",
+	Location:     SanLunLocation{LogicalUnit: "lun1", Volume: SanLunVolume{Name: "vol1"}},
+	Space:        SanLunSpace{Size: 10737418240, Guarantee: SanLunSpaceReserve{Requested: true, Reserved: true}},
+	QosPolicy:    SanLunQosPolicy{Name: "qos1"},
+	SVM:          SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+}
+
+func TestGetSanLunByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	badRecord := struct{ Enabled int }{123}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(sanLunRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(badRecord, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	genericError := errors.New("generic error for UT")
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	query := "fields=svm.name,svm.uuid,name,uuid,location.logical_unit,location.volume.name,os_type,space.size,space.guarantee.requested,space.guarantee.reserved,qos_policy.name,enabled,comment,serial_number" +
+		"&svm.name=svm1&name=/vol/vol1/lun1"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", ExpectedQuery: query, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", ExpectedQuery: query, StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/luns", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SanLunGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &sanLunRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSanLunByName(errorHandler, *r, "svm1", "/vol/vol1/lun1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSanLunByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSanLunByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSanLun(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	reserved := false
+	body := SanLunResourceBodyDataModelONTAP{
+		SVM:    &SvmDataModelONTAP{Name: "svm1"},
+		Name:   "/vol/vol1/lun1",
+		OsType: "linux",
+		Space:  &SanLunSpaceBody{Size: 10737418240, Guarantee: &SanLunSpaceReserveBody{Requested: &reserved}},
+	}
+	expectedBody := map[string]any{
+		"svm":     map[string]any{"name": "svm1"},
+		"name":    "/vol/vol1/lun1",
+		"os_type": "linux",
+		"space":   map[string]any{"size": 10737418240, "guarantee": map[string]any{"requested": false}},
+	}
+	var recordInterface map[string]any
+	err := mapstructure.Decode(SanLunGetDataModelONTAP{Name: "/vol/vol1/lun1", UUID: "5678"}, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/luns", ExpectedQuery: "return_records=true&return_timeout=60", ExpectedBody: expectedBody, StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/luns", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SanLunGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &SanLunGetDataModelONTAP{Name: "/vol/vol1/lun1", UUID: "5678"}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateSanLun(errorHandler, *r, body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSanLun() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateSanLun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSanLun(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	enabled := false
+	body := SanLunResourceBodyDataModelONTAP{
+		Space:     &SanLunSpaceBody{Size: 21474836480},
+		QosPolicy: &SanLunQosPolicy{Name: "qos2"},
+		Enabled:   &enabled,
+	}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/luns/5678",
+				ExpectedBody: map[string]any{"space": map[string]any{"size": 21474836480}, "qos_policy": map[string]any{"name": "qos2"}, "enabled": false},
+				StatusCode:   200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/luns/5678", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateSanLun(errorHandler, *r, body, "5678")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSanLun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteSanLun(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/luns/5678", StatusCode: 200, Response: restclient.RestResponse{}, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/luns/5678", StatusCode: 400, Response: restclient.RestResponse{}, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete", responses: responses["test_delete"], wantErr: false},
+		{name: "test_error", responses: responses["test_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteSanLun(errorHandler, *r, "5678")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteSanLun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
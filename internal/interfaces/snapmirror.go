@@ -90,11 +90,12 @@ type SnapmirrorPolicy struct {
 func GetSnapmirrorByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string) (*SnapmirrorGetDataModelONTAP, error) {
 	api := "snapmirror/relationships/" + id
 	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror info", fmt.Sprintf("error on GET %s: %s", api, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror info", fmt.Sprintf("error on GET %s: %s", api, err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 	var rawDataONTAP SnapmirrorGetDataModelONTAP
 	if err := mapstructure.Decode(response, &rawDataONTAP); err != nil {
@@ -120,7 +121,7 @@ func GetSnapmirrorByDestinationPath(errorHandler *utils.ErrorHandler, r restclie
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror/relationships info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror/relationships info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP SnapmirrorDataSourceModel
@@ -162,7 +163,7 @@ func GetSnapmirrors(errorHandler *utils.ErrorHandler, r restclient.RestClient, f
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror/relationships info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror/relationships info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []SnapmirrorDataSourceModel
@@ -190,7 +191,7 @@ func CreateSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating snapmirror", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating snapmirror", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var rawDataONTAP SnapmirrorGetRawDataModelONTAP
@@ -210,7 +211,7 @@ func InitializeSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallUpdateMethod(api, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error initializing snapmirror", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, response %#v", api, err, statusCode, response))
+		return errorHandler.MakeAndReportRestError("error initializing snapmirror", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, response %#v", api, err, statusCode, response), err)
 	}
 
 	return nil
@@ -221,7 +222,7 @@ func DeleteSnapmirror(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 	api := "snapmirror/relationships/" + id
 	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting snapmirror/relationships", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting snapmirror/relationships", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -115,11 +115,12 @@ type SnapmirrorPolicyFilterModel struct {
 func GetSnapmirrorPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, id string) (*SnapmirrorPolicyGetRawDataModelONTAP, error) {
 	api := "snapmirror/policies/" + id
 	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror policy info", fmt.Sprintf("error on GET %s: %s", api, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror policy info", fmt.Sprintf("error on GET %s: %s", api, err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 	var rawDataONTAP SnapmirrorPolicyGetRawDataModelONTAP
 	if err := mapstructure.Decode(response, &rawDataONTAP); err != nil {
@@ -147,7 +148,7 @@ func GetSnapmirrorPolicyByName(errorHandler *utils.ErrorHandler, r restclient.Re
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror/policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror/policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP SnapmirrorPolicyGetDataModelONTAP
@@ -181,7 +182,7 @@ func GetSnapmirrorPolicyDataSourceByName(errorHandler *utils.ErrorHandler, r res
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror/policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror/policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP SnapmirrorPolicyGetRawDataModelONTAP
@@ -221,7 +222,7 @@ func GetSnapmirrorPolicies(errorHandler *utils.ErrorHandler, r restclient.RestCl
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapmirror/policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapmirror/policies info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []SnapmirrorPolicyGetRawDataModelONTAP
@@ -248,7 +249,7 @@ func CreateSnapmirrorPolicy(errorHandler *utils.ErrorHandler, r restclient.RestC
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating snapmirror/policies", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating snapmirror/policies", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var rawDataONTAP SnapmirrorPolicyGetRawDataModelONTAP
@@ -271,7 +272,7 @@ func UpdateSnapmirrorPolicy(errorHandler *utils.ErrorHandler, r restclient.RestC
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallUpdateMethod(api, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating export policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, response %#v", api, err, statusCode, response))
+		return errorHandler.MakeAndReportRestError("error updating export policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d, response %#v", api, err, statusCode, response), err)
 	}
 
 	return nil
@@ -282,7 +283,7 @@ func DeleteSnapmirrorPolicy(errorHandler *utils.ErrorHandler, r restclient.RestC
 	api := "snapmirror/policies/"
 	statusCode, _, err := r.CallDeleteMethod(api+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting snapmirror/policies", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting snapmirror/policies", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
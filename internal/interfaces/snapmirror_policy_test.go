@@ -196,25 +196,31 @@ func TestGetSnapmirrorPolicy(t *testing.T) {
 	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
 	responses := map[string][]restclient.MockResponse{
 		"test_no_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_one_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_one_retention_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: oneRetentionRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: oneRetentionRecord, Err: nil},
 		},
 		"test_one_sync_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: oneSyncRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: oneSyncRecord, Err: nil},
 		},
 		"test_one_sync_retention_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: oneSyncRetentionRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: oneSyncRetentionRecord, Err: nil},
 		},
 		"test_two_records_error": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: twoRecords, Err: genericError},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: twoRecords, Err: genericError},
 		},
 		"test_error_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies", StatusCode: 200, Response: decodeError, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+		"test_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 404, Response: noRecords, Err: &restclient.ONTAPError{Code: "4", Message: "entry doesn't exist", StatusCode: 404}},
+		},
+		"test_server_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "snapmirror/policies/1234", StatusCode: 500, Response: noRecords, Err: &restclient.ONTAPError{StatusCode: 500}},
 		},
 	}
 	tests := []struct {
@@ -223,7 +229,9 @@ func TestGetSnapmirrorPolicy(t *testing.T) {
 		want      *SnapmirrorPolicyGetRawDataModelONTAP
 		wantErr   bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_not_found_1", responses: responses["test_not_found_1"], want: nil, wantErr: false},
+		{name: "test_server_error_1", responses: responses["test_server_error_1"], want: nil, wantErr: true},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &basicSnapmirrorPolicyRecord, wantErr: false},
 		{name: "test_one_retention_record_1", responses: responses["test_one_retention_record_1"], want: &basicSnapmirrorPolicyRetentionRecord, wantErr: false},
 		{name: "test_one_sync_record_1", responses: responses["test_one_sync_record_1"], want: &basicSnapmirrorPolicySyncRecord, wantErr: false},
@@ -233,11 +241,11 @@ func TestGetSnapmirrorPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetSnapmirrorPolicy(errorHandler, *r, "string")
+			got, err := GetSnapmirrorPolicy(errorHandler, *r, "1234")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -317,7 +325,7 @@ func TestCreateSnapmirrorPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -368,7 +376,7 @@ func TestDeleteSnapmirrorPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -411,11 +419,11 @@ func TestUpdateSnapmirrorPolicy(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			err = UpdateSnapmirrorPolicy(errorHandler, *r, tt.requestbody, "string")
+			err = UpdateSnapmirrorPolicy(errorHandler, *r, tt.requestbody, "1234")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -67,16 +67,17 @@ type AggregateBlockStoragePrimary struct {
 
 // GetStorageAggregate to get aggregate info by uuid
 func GetStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*StorageAggregateGetDataModelONTAP, error) {
-	api := "storage/aggregates/"
+	api := "storage/aggregates"
 	query := r.NewQuery()
 	query.Set("uuid", uuid)
 	query.Fields([]string{"name", "snaplock_type", "block_storage", "data_encryption", "state"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage aggregate info", fmt.Sprintf("error on GET storage/aggregates/%s: %s", uuid, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage aggregate info", fmt.Sprintf("error on GET storage/aggregates/%s: %s", uuid, err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP StorageAggregateGetDataModelONTAP
@@ -99,7 +100,7 @@ func GetStorageAggregateByName(errorHandler *utils.ErrorHandler, r restclient.Re
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage aggregate info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage aggregate info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP StorageAggregateGetDataModelONTAP
@@ -128,7 +129,7 @@ func GetStorageAggregates(errorHandler *utils.ErrorHandler, r restclient.RestCli
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage aggregate info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage aggregate info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []StorageAggregateGetDataModelONTAP
@@ -157,7 +158,7 @@ func CreateStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestC
 	}
 	statusCode, response, err := r.CallCreateMethod("storage/aggregates", query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating aggregate", fmt.Sprintf("error on POST storage/aggregates: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating aggregate", fmt.Sprintf("error on POST storage/aggregates: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP StorageAggregateGetDataModelONTAP
@@ -182,7 +183,7 @@ func UpdateStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestC
 	// API has no option to return records
 	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("storage/aggregates/%s", uuid), query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating aggregate", fmt.Sprintf("error on PATCH storage/aggregates: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating aggregate", fmt.Sprintf("error on PATCH storage/aggregates: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
@@ -191,7 +192,7 @@ func UpdateStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestC
 func DeleteStorageAggregate(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
 	statusCode, _, err := r.CallDeleteMethod("storage/aggregates/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting aggregate", fmt.Sprintf("error on DELETE storage/aggregates: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting aggregate", fmt.Sprintf("error on DELETE storage/aggregates: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
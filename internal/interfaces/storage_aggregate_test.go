@@ -80,7 +80,7 @@ func TestGetStorageAggregate(t *testing.T) {
 			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_one_record_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", ExpectedQuery: "fields=name,snaplock_type,block_storage,data_encryption,state&uuid=string", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_error": {
 			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates", StatusCode: 200, Response: twoRecords, Err: genericError},
@@ -95,14 +95,14 @@ func TestGetStorageAggregate(t *testing.T) {
 		want      *StorageAggregateGetDataModelONTAP
 		wantErr   bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &basicStorageAggregateRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -157,7 +157,7 @@ func TestCreateStorageAggregate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -185,7 +185,7 @@ func TestDeleteStorageAggregate(t *testing.T) {
 			{ExpectedMethod: "DELETE", ExpectedURL: "storage/aggregates/1234", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_error_2": {
-			{ExpectedMethod: "GET", ExpectedURL: "storage/aggregates/1234", StatusCode: 200, Response: noRecords, Err: genericError},
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/aggregates/1234", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
 	}
 	tests := []struct {
@@ -198,7 +198,7 @@ func TestDeleteStorageAggregate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
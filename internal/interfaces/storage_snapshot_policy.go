@@ -61,11 +61,12 @@ func GetSnapshotPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	query.Set("uuid", id)
 	query.Fields([]string{"name", "svm.name", "copies", "scope", "enabled"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage_snapshot_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage_snapshot_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP SnapshotPolicyGetDataModelONTAP
@@ -88,7 +89,7 @@ func GetSnapshotPolicyByName(errorHandler *utils.ErrorHandler, r restclient.Rest
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage_snapshot_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage_snapshot_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP SnapshotPolicyGetDataModelONTAP
@@ -117,7 +118,7 @@ func GetSnapshotPolicies(errorHandler *utils.ErrorHandler, r restclient.RestClie
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage_snapshot_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage_snapshot_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []SnapshotPolicyGetDataModelONTAP
@@ -146,7 +147,7 @@ func CreateSnapshotPolicy(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating storage_snapshot_policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating storage_snapshot_policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP SnapshotPolicyGetDataModelONTAP
@@ -162,7 +163,7 @@ func DeleteSnapshotPolicy(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	api := "storage/snapshot-policies"
 	statusCode, _, err := r.CallDeleteMethod(api+"/"+id, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting storage_snapshot_policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting storage_snapshot_policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -177,7 +178,7 @@ func UpdateSnapshotPolicy(errorHandler *utils.ErrorHandler, r restclient.RestCli
 
 	statusCode, _, err := r.CallUpdateMethod(api+"/"+id, nil, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating snapshot policy", fmt.Sprintf("error on PATCH storage/snapshot-policies: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating snapshot policy", fmt.Sprintf("error on PATCH storage/snapshot-policies: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
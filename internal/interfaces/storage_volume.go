@@ -187,8 +187,8 @@ func GetUUIDVolumeByName(errorHandler *utils.ErrorHandler, r restclient.RestClie
 	api := "storage/volumes/"
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading volume info",
-			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading volume info",
+			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 
 	if response == nil {
@@ -212,7 +212,7 @@ func GetStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "analytics.state"})
 	statusCode, response, err := r.GetNilOrOneRecord("storage/volumes/"+uuid, query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading volume info", fmt.Sprintf("error on GET storage/volumes: %s", err))
+		return nil, errorHandler.MakeAndReportRestError("error reading volume info", fmt.Sprintf("error on GET storage/volumes: %s", err), err)
 	}
 	log.Printf("raw is: %#v", response)
 	var dataONTAP *StorageVolumeGetDataModelONTAP
@@ -231,10 +231,10 @@ func GetStorageVolumeByName(errorHandler *utils.ErrorHandler, r restclient.RestC
 	query.Add("return_records", "true")
 	query.Fields([]string{"name", "svm.name", "aggregates", "space.size", "state", "type", "nas.export_policy.name", "nas.path", "guarantee.type", "space.snapshot.reserve_percent",
 		"nas.security_style", "encryption.enabled", "efficiency.policy.name", "nas.unix_permissions", "nas.gid", "nas.uid", "snapshot_policy.name", "language", "qos.policy.name",
-		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "analytics.state"})
+		"tiering.policy", "comment", "efficiency.compression", "tiering.min_cooling_days", "space.logical_space.enforcement", "space.logical_space.reporting", "snaplock.type", "analytics.state", "uuid"})
 	statusCode, response, err := r.GetNilOrOneRecord("storage/volumes", query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading volume info by name", fmt.Sprintf("error on GET storage/volumes: %s", err))
+		return nil, errorHandler.MakeAndReportRestError("error reading volume info by name", fmt.Sprintf("error on GET storage/volumes: %s", err), err)
 	}
 
 	if response == nil {
@@ -269,7 +269,7 @@ func GetStorageVolumes(errorHandler *utils.ErrorHandler, r restclient.RestClient
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading storage volume info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading storage volume info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP []StorageVolumeGetDataModelONTAP
@@ -295,7 +295,7 @@ func CreateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClie
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod("storage/volumes", query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating volume", fmt.Sprintf("error on POST storage/volumes: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating volume", fmt.Sprintf("error on POST storage/volumes: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP StorageVolumeGetDataModelONTAP
@@ -310,7 +310,7 @@ func CreateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClie
 func DeleteStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
 	statusCode, _, err := r.CallDeleteMethod("storage/volumes/"+uuid, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting volume", fmt.Sprintf("error on DELETE storage/volumes: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting volume", fmt.Sprintf("error on DELETE storage/volumes: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
@@ -324,7 +324,7 @@ func UpddateStorageVolume(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	log.Printf("body body: %#v", body)
 	statusCode, _, err := r.CallUpdateMethod("storage/volumes/"+ID, nil, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating volume", fmt.Sprintf("error on POST storage/volumes: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating volume", fmt.Sprintf("error on POST storage/volumes: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
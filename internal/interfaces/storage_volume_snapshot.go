@@ -46,8 +46,8 @@ func GetUUIDStorageVolumeSnapshotsByName(errorHandler *utils.ErrorHandler, r res
 	api := "storage/volumes/" + volumeUUID + "/snapshots"
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapshot info",
-			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapshot info",
+			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 
 	if response == nil {
@@ -67,11 +67,12 @@ func GetUUIDStorageVolumeSnapshotsByName(errorHandler *utils.ErrorHandler, r res
 func GetStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, UUID string) (*StorageVolumeSnapshotGetDataModelONTAP, error) {
 	api := fmt.Sprintf("storage/volumes/%s/snapshots/%s", volumeUUID, UUID)
 	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
-	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
-	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapshot info", fmt.Sprintf("error on GET storage/volumes/%s/snapshots/%s: %s", volumeUUID, UUID, err))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapshot info", fmt.Sprintf("error on GET storage/volumes/%s/snapshots/%s: %s", volumeUUID, UUID, err), err)
+	}
+	if response == nil {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("no record found for GET %s", api))
+		return nil, nil
 	}
 
 	var dataONTAP StorageVolumeSnapshotGetDataModelONTAP
@@ -90,8 +91,8 @@ func GetStorageVolumeSnapshots(errorHandler *utils.ErrorHandler, r restclient.Re
 	api := "storage/volumes/" + volumeUUID + "/snapshots"
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapshot info",
-			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapshot info",
+			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 
 	if response == nil {
@@ -122,8 +123,8 @@ func GetListStorageVolumeSnapshots(errorHandler *utils.ErrorHandler, r restclien
 	api := "storage/volumes/" + volumeUUID + "/snapshots"
 	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading snapshots info",
-			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading snapshots info",
+			fmt.Sprintf("error on GET %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 
 	if response == nil {
@@ -157,8 +158,8 @@ func CreateStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.
 	api := "storage/volumes/" + volumeUUID + "/snapshots"
 	statusCode, response, err := r.CallCreateMethod(api, query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating snapshot",
-			fmt.Sprintf("error on POST %s: %s, statuscode: %d", api, err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating snapshot",
+			fmt.Sprintf("error on POST %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 
 	var dataONTAP StorageVolumeSnapshotGetDataModelONTAP
@@ -183,7 +184,7 @@ func UpdateStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.
 	api := fmt.Sprintf("storage/volumes/%s/snapshots/%s", volumeUUID, UUID)
 	statusCode, _, err := r.CallUpdateMethod(api, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating snapshot", fmt.Sprintf("error on PATCH storage/volumes/%s/snapshots/%s: %s, statusCode %d", volumeUUID, UUID, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating snapshot", fmt.Sprintf("error on PATCH storage/volumes/%s/snapshots/%s: %s, statusCode %d", volumeUUID, UUID, err, statusCode), err)
 	}
 	return nil
 }
@@ -193,8 +194,8 @@ func DeleteStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.
 	api := "storage/volumes/" + volumeUUID + "/snapshots/" + uuid
 	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting snapshot info",
-			fmt.Sprintf("error on DELETE %s: %s, statuscode: %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting snapshot info",
+			fmt.Sprintf("error on DELETE %s: %s, statuscode: %d", api, err, statusCode), err)
 	}
 	return nil
 }
@@ -118,18 +118,18 @@ func TestGetStorageVolumeSnapshot(t *testing.T) {
 		want      *StorageVolumeSnapshotGetDataModelONTAP
 		wantErr   bool
 	}{
-		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: true},
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &basicStorageVolumeSnapshotRecord, wantErr: false},
 		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
 		{name: "test_get_error_1", responses: responses["test_get_error_1"], want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetStorageVolumeSnapshot(errorHandler, *r, "string", "string")
+			got, err := GetStorageVolumeSnapshot(errorHandler, *r, "1234", "5678")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -195,11 +195,11 @@ func TestGetListStorageVolumeSnapshots(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetListStorageVolumeSnapshots(errorHandler, *r, "string", nil)
+			got, err := GetListStorageVolumeSnapshots(errorHandler, *r, "1234", nil)
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -259,11 +259,11 @@ func TestCreateStorageVolumeSnapshot(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			got, err := CreateStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, "string")
+			got, err := CreateStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, "1234")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -300,11 +300,11 @@ func TestDeleteStorageVolumeSnapshot(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			err2 := DeleteStorageVolumeSnapshot(errorHandler, *r, "string", "string")
+			err2 := DeleteStorageVolumeSnapshot(errorHandler, *r, "1234", "5678")
 			if err2 != nil {
 				fmt.Printf("err2: %s\n", err)
 			}
@@ -343,11 +343,11 @@ func TestUpdateStorageVolumeSnapshot(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
-			err = UpdateStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, "string", "string")
+			err = UpdateStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, "1234", "5678")
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -66,7 +66,7 @@ type SvmDataSourceFilterModel struct {
 func GetSvm(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*SvmGetDataModelONTAP, error) {
 	statusCode, response, err := r.GetNilOrOneRecord("svm/svms/"+uuid, nil, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP *SvmGetDataModelONTAP
@@ -83,7 +83,7 @@ func GetSvmByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, nam
 	query.Add("name", name)
 	statusCode, response, err := r.GetNilOrOneRecord("svm/svms", query, nil)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP *SvmGetDataModelONTAP
@@ -105,7 +105,7 @@ func GetSvmByNameDataSource(errorHandler *utils.ErrorHandler, r restclient.RestC
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP SvmGetDataSourceModel
@@ -135,7 +135,7 @@ func GetSvmsByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, fi
 		err = fmt.Errorf("no response for GET %s", api)
 	}
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error reading svm info", fmt.Sprintf("error on GET svm/svms: %s, statusCode %d", err, statusCode), err)
 	}
 
 	var dataONTAP []SvmGetDataSourceModel
@@ -167,7 +167,7 @@ func CreateSvm(errorHandler *utils.ErrorHandler, r restclient.RestClient, data S
 	query.Add("return_records", "true")
 	statusCode, response, err := r.CallCreateMethod("svm/svms", query, body)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating svm", fmt.Sprintf("error on POST svm/svms: %s, statusCode %d", err, statusCode))
+		return nil, errorHandler.MakeAndReportRestError("error creating svm", fmt.Sprintf("error on POST svm/svms: %s, statusCode %d", err, statusCode), err)
 
 	}
 
@@ -185,7 +185,7 @@ func DeleteSvm(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid s
 	api := "svm/svms/" + uuid
 	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error deleting svm", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+		return errorHandler.MakeAndReportRestError("error deleting svm", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode), err)
 
 	}
 	return nil
@@ -209,7 +209,7 @@ func UpdateSvm(errorHandler *utils.ErrorHandler, r restclient.RestClient, data S
 	query.Add("return_records", "true")
 	statusCode, _, err := r.CallUpdateMethod("svm/svms/"+uuid, query, body)
 	if err != nil {
-		return errorHandler.MakeAndReportError("error updating svm", fmt.Sprintf("error on PATCH svm/svms: %s, statusCode %d", err, statusCode))
+		return errorHandler.MakeAndReportRestError("error updating svm", fmt.Sprintf("error on PATCH svm/svms: %s, statusCode %d", err, statusCode), err)
 	}
 	return nil
 }
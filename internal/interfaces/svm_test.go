@@ -73,14 +73,14 @@ func TestGetSvmByNameDataSource(t *testing.T) {
 	badRecordResponse := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
 	responses := map[string][]restclient.MockResponse{
 		"test_no_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_one_record_1": {
 
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_error": {
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: twoRecords, Err: genericError},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: twoRecords, Err: genericError},
 		},
 		"test_decode_error": {
 			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: badRecordResponse, Err: nil},
@@ -100,7 +100,7 @@ func TestGetSvmByNameDataSource(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -150,17 +150,17 @@ func TestGetSvmsByName(t *testing.T) {
 
 	responses := map[string][]restclient.MockResponse{
 		"test_no_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: noRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: noRecords, Err: nil},
 		},
 		"test_one_record_1": {
 
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: oneRecord, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_1": {
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: twoRecords, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: twoRecords, Err: nil},
 		},
 		"test_decode_error": {
-			{ExpectedMethod: "GET", ExpectedURL: "svm", StatusCode: 200, Response: badRecordResponse, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: badRecordResponse, Err: nil},
 		},
 	}
 	tests := []struct {
@@ -177,7 +177,7 @@ func TestGetSvmsByName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := restclient.NewMockedRestClient(tt.responses)
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CLICommandResource{}
+
+var _ resource.ResourceWithValidateConfig = &CLICommandResource{}
+
+// NewCLICommandResource is a helper function to simplify the provider implementation.
+func NewCLICommandResource() resource.Resource {
+	return &CLICommandResource{
+		config: resourceOrDataSourceConfig{
+			name: "cli_command_resource",
+		},
+	}
+}
+
+// CLICommandResource defines the resource implementation.
+type CLICommandResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// CLICommandResourceModel describes the resource data model.
+type CLICommandResourceModel struct {
+	CxProfileName  types.String     `tfsdk:"cx_profile_name"`
+	Create         *CLICommandModel `tfsdk:"create"`
+	Read           *CLICommandModel `tfsdk:"read"`
+	Destroy        *CLICommandModel `tfsdk:"destroy"`
+	ReadOnly       types.Bool       `tfsdk:"read_only"`
+	IdempotencyKey types.String     `tfsdk:"idempotency_key"`
+	Records        types.List       `tfsdk:"records"`
+	ID             types.String     `tfsdk:"id"`
+}
+
+// CLICommandModel describes a CLI command
+type CLICommandModel struct {
+	Command types.String            `tfsdk:"command"`
+	Verb    types.String            `tfsdk:"verb"`
+	Params  map[string]types.String `tfsdk:"params"`
+	Body    types.String            `tfsdk:"body"`
+}
+
+// Metadata returns the resource type name.
+func (r *CLICommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// cliCommandSchema returns the schema of a command, verb defaults to defaultVerb
+func cliCommandSchema(description string, defaultVerb string, planModifiers []planmodifier.Object) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		PlanModifiers:       planModifiers,
+		Attributes: map[string]schema.Attribute{
+			"command": schema.StringAttribute{
+				MarkdownDescription: "CLI command, without the verb and the parameters, eg vserver nfs for vserver nfs modify",
+				Required:            true,
+			},
+			"verb": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("HTTP method: GET for show, POST for create, PATCH for modify, DELETE for delete. Defaults to %s", defaultVerb),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "POST", "PATCH", "DELETE"),
+				},
+			},
+			"params": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Query parameters, eg to select the objects to show, modify or delete, or the fields to show",
+				Optional:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded body, with the fields to set for create and modify, eg with jsonencode",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *CLICommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Runs ONTAP CLI commands through the private CLI passthrough, for settings that have no REST equivalent",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"create": cliCommandSchema("Command run on create. A change runs the destroy command, then the new create command",
+				"POST", []planmodifier.Object{objectplanmodifier.RequiresReplace()}),
+			"read": cliCommandSchema("Command run on create and refresh, to read the records. If it returns no record, the create command is run again on the next apply",
+				"GET", nil),
+			"destroy": cliCommandSchema("Command run on destroy. If not set, the resource is only removed from the state",
+				"DELETE", nil),
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Only run the read command, for data lookups. create and destroy must not be set, and the read verb must be GET",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"idempotency_key": schema.StringAttribute{
+				MarkdownDescription: "The create command is only run once per key. A change runs the destroy command, then the create command again",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.ListAttribute{
+				ElementType:         types.MapType{ElemType: types.StringType},
+				MarkdownDescription: "Records returned by the read command, or by the create command if read is not set. Nested objects use dot separated keys, lists are JSON encoded",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "idempotency_key if set, or the method, path and parameters of the create command, or of the read command in read only mode",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CLICommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected  Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ValidateConfig checks that the commands match the mode, and that the bodies are JSON objects
+func (r *CLICommandResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CLICommandResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ReadOnly.ValueBool() {
+		if data.Create != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("create"), "Invalid create", "create must not be set when read_only is true")
+		}
+		if data.Destroy != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("destroy"), "Invalid destroy", "destroy must not be set when read_only is true")
+		}
+		if data.Read == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("read"), "Missing read", "read is required when read_only is true")
+		} else if !data.Read.Verb.IsNull() && !data.Read.Verb.IsUnknown() && data.Read.Verb.ValueString() != "GET" {
+			resp.Diagnostics.AddAttributeError(path.Root("read").AtName("verb"), "Invalid verb", fmt.Sprintf("read verb must be GET when read_only is true, got %s", data.Read.Verb.ValueString()))
+		}
+	} else if !data.ReadOnly.IsUnknown() && data.Create == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("create"), "Missing create", "create is required when read_only is false")
+	}
+
+	for name, command := range map[string]*CLICommandModel{"create": data.Create, "read": data.Read, "destroy": data.Destroy} {
+		if command == nil || command.Body.IsNull() || command.Body.IsUnknown() {
+			continue
+		}
+		if _, err := interfaces.DecodeRestAPIBody(command.Body.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name).AtName("body"), "Invalid JSON body", fmt.Sprintf("%s body: %s", name, err))
+		}
+	}
+}
+
+// cliCommand converts a command to its interfaces representation
+func (command *CLICommandModel) cliCommand(defaultVerb string) (interfaces.CLICommand, error) {
+	cliCommand := interfaces.CLICommand{
+		Command: command.Command.ValueString(),
+		Verb:    defaultVerb,
+		Params:  map[string]string{},
+	}
+	if !command.Verb.IsNull() {
+		cliCommand.Verb = command.Verb.ValueString()
+	}
+	for key, value := range command.Params {
+		cliCommand.Params[key] = value.ValueString()
+	}
+	body, err := interfaces.DecodeRestAPIBody(command.Body.ValueString())
+	if err != nil {
+		return cliCommand, fmt.Errorf("error decoding body of %s: %s", cliCommand.Command, err)
+	}
+	cliCommand.Body = body
+	return cliCommand, nil
+}
+
+// cliCommandID returns the method, path and parameters of a command, eg PATCH private/cli/vserver/nfs?vserver=svm1
+func cliCommandID(command interfaces.CLICommand) string {
+	params := url.Values{}
+	for key, value := range command.Params {
+		params.Set(key, value)
+	}
+	id := command.Verb + " " + interfaces.CLICommandPath(command.Command)
+	if len(params) > 0 {
+		id += "?" + params.Encode()
+	}
+	return id
+}
+
+// runCommand runs a command, and sets records from its output
+func (r *CLICommandResource) runCommand(ctx context.Context, errorHandler *utils.ErrorHandler, client *restclient.RestClient, command *CLICommandModel, defaultVerb string, data *CLICommandResourceModel) ([]map[string]interface{}, error) {
+	cliCommand, err := command.cliCommand(defaultVerb)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("invalid command", err.Error())
+	}
+	records, err := interfaces.RunCLICommand(errorHandler, *client, cliCommand)
+	if err != nil {
+		// error reporting done inside RunCLICommand
+		return nil, err
+	}
+	flattened := make([]map[string]string, len(records))
+	for index, record := range records {
+		flattened[index] = interfaces.FlattenRestAPIRecord(record)
+	}
+	recordsValue, diags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, flattened)
+	if diags.HasError() {
+		return nil, errorHandler.MakeAndReportError("error setting records", fmt.Sprintf("error converting records %v: %v", flattened, diags))
+	}
+	data.Records = recordsValue
+	return records, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CLICommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CLICommandResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Read == nil {
+		// nothing to refresh
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	records, err := r.runCommand(ctx, errorHandler, client, data.Read, "GET", &data)
+	if err != nil {
+		return
+	}
+	if len(records) == 0 && !data.ReadOnly.ValueBool() {
+		removeResourceNotFound(ctx, resp, "cli_command", data.ID.ValueString())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create runs the create command, or the read command in read only mode
+func (r *CLICommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CLICommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	command, defaultVerb := data.Create, "POST"
+	if data.ReadOnly.ValueBool() {
+		command, defaultVerb = data.Read, "GET"
+	}
+	cliCommand, err := command.cliCommand(defaultVerb)
+	if err != nil {
+		errorHandler.MakeAndReportError("invalid command", err.Error())
+		return
+	}
+	if _, err := r.runCommand(ctx, errorHandler, client, command, defaultVerb, data); err != nil {
+		return
+	}
+	if data.Read != nil && !data.ReadOnly.ValueBool() {
+		if _, err := r.runCommand(ctx, errorHandler, client, data.Read, "GET", data); err != nil {
+			return
+		}
+	}
+
+	if data.IdempotencyKey.IsNull() {
+		data.ID = types.StringValue(cliCommandID(cliCommand))
+	} else {
+		data.ID = data.IdempotencyKey
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, id=%s", data.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only refreshes the records, as a change to create or idempotency_key recreates the resource.
+func (r *CLICommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CLICommandResourceModel
+	var state *CLICommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Read == nil {
+		data.Records = state.Records
+	} else {
+		client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+		if err != nil {
+			return
+		}
+		if _, err := r.runCommand(ctx, errorHandler, client, data.Read, "GET", data); err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete runs the destroy command, if any, and removes the Terraform state on success.
+func (r *CLICommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CLICommandResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ReadOnly.ValueBool() || data.Destroy == nil {
+		tflog.Debug(ctx, fmt.Sprintf("no destroy command for %s", data.ID.ValueString()))
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if _, err := r.runCommand(ctx, errorHandler, client, data.Destroy, "DELETE", data); err != nil {
+		return
+	}
+}
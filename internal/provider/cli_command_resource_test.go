@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCLICommandResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create is required unless read_only is set
+			{
+				Config:      testAccCLICommandResourceReadOnlyConfig("false"),
+				ExpectError: regexp.MustCompile("create is required when read_only is false"),
+			},
+			// read only lookup
+			{
+				Config: testAccCLICommandResourceReadOnlyConfig("true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cli_command_resource.example", "id", "GET private/cli/vserver?fields=vserver%2Cipspace&vserver=carchi-test"),
+					resource.TestCheckResourceAttr("netapp-ontap_cli_command_resource.example", "records.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_cli_command_resource.example", "records.0.vserver", "carchi-test"),
+				),
+			},
+			// create and destroy a job schedule
+			{
+				Config: testAccCLICommandResourceConfig("acc_test_cli"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cli_command_resource.example", "id", "acc_test_cli"),
+					resource.TestCheckResourceAttr("netapp-ontap_cli_command_resource.example", "records.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_cli_command_resource.example", "records.0.name", "acc_test_cli"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCLICommandResourceProviderConfig() string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+`, host, admin, password)
+}
+
+func testAccCLICommandResourceReadOnlyConfig(readOnly string) string {
+	return testAccCLICommandResourceProviderConfig() + fmt.Sprintf(`
+resource "netapp-ontap_cli_command_resource" "example" {
+  cx_profile_name = "cluster4"
+  read_only = %s
+  read = {
+    command = "vserver"
+    params = {
+      vserver = "carchi-test"
+      fields = "vserver,ipspace"
+    }
+  }
+}
+`, readOnly)
+}
+
+func testAccCLICommandResourceConfig(name string) string {
+	return testAccCLICommandResourceProviderConfig() + fmt.Sprintf(`
+resource "netapp-ontap_cli_command_resource" "example" {
+  cx_profile_name = "cluster4"
+  idempotency_key = "%s"
+  create = {
+    command = "job schedule cron"
+    body = jsonencode({
+      name = "%s"
+      minute = "10"
+    })
+  }
+  read = {
+    command = "job schedule cron"
+    params = {
+      name = "%s"
+      fields = "name,minute"
+    }
+  }
+  destroy = {
+    command = "job schedule cron"
+    params = {
+      name = "%s"
+    }
+  }
+}
+`, name, name, name, name)
+}
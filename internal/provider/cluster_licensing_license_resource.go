@@ -62,6 +62,7 @@ func (r *ClusterLicensingLicenseResource) Schema(ctx context.Context, req resour
 				Required:            true,
 				MarkdownDescription: "List of NLF or 26-character keys",
 				ElementType:         types.StringType,
+				Sensitive:           true,
 			},
 			"name": schema.StringAttribute{
 				Computed:            true,
@@ -107,6 +108,10 @@ func (r *ClusterLicensingLicenseResource) Create(ctx context.Context, req resour
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSensitiveValues(ctx, data.Keys)
 
 	var body interfaces.ClusterLicensingLicenseResourceBodyDataModelONTAP
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
@@ -155,6 +160,7 @@ func (r *ClusterLicensingLicenseResource) Read(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = maskSensitiveValues(ctx, data.Keys)
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	// we need to defer setting the client until we can read the connection profile name
@@ -173,13 +179,17 @@ func (r *ClusterLicensingLicenseResource) Read(ctx context.Context, req resource
 		return // TODO: Fix
 	}
 
-	var matchingLicense interfaces.ClusterLicensingLicenseKeyDataModelONTAP
+	var matchingLicense *interfaces.ClusterLicensingLicenseKeyDataModelONTAP
 
-	for _, item := range restInfo {
+	for index, item := range restInfo {
 		if data.Name.ValueString() == item.Name {
-			matchingLicense = item
+			matchingLicense = &restInfo[index]
 		}
 	}
+	if matchingLicense == nil || len(matchingLicense.Licenses) == 0 {
+		removeResourceNotFound(ctx, resp, "license", data.Name.ValueString())
+		return
+	}
 
 	data.Name = types.StringValue(matchingLicense.Name)
 	data.State = types.StringValue(matchingLicense.State)
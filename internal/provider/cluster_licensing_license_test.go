@@ -9,6 +9,7 @@ import (
 )
 
 func TestLicensingLicenseResouce(t *testing.T) {
+	testAccReplay(t)
 	testLicense := os.Getenv("TF_ACC_NETAPP_LICENSE")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
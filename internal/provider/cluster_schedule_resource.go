@@ -164,7 +164,7 @@ func (r *ClusterScheduleResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No Cluster Schedule found", fmt.Sprintf("Cluster Schedule %s not found.", data.Name.ValueString()))
+		removeResourceNotFound(ctx, resp, "cluster schedule", data.Name.ValueString())
 		return
 	}
 	// data.Name = types.StringValue(restInfo.Name)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
@@ -13,13 +15,28 @@ import (
 
 // ConnectionProfile describes how to reach a cluster or svm
 type ConnectionProfile struct {
-	// TODO: add certs in addition to basic authentication
-	// TODO: Add Timeout (currently hardcoded to 10 seconds)
-	Hostname              string
-	Username              string
-	Password              string
-	ValidateCerts         bool
-	MaxConcurrentRequests int
+	Hostname               string
+	Port                   int
+	Scheme                 string
+	APIRoot                string
+	ProxyURL               string
+	Username               string
+	Password               string
+	ValidateCerts          bool
+	MaxConcurrentRequests  int
+	PageSize               int
+	RecordLimit            int
+	Retry                  restclient.RetryPolicy
+	ClientCertificate      string
+	ClientKey              string
+	CACertificate          string
+	TLSServerName          string
+	CertificateFingerprint string
+	MinTLSVersion          string
+	ConnectTimeout         time.Duration
+	TLSHandshakeTimeout    time.Duration
+	ResponseTimeout        time.Duration
+	ReturnTimeout          int
 }
 
 // Config is created by the provide configure method
@@ -27,48 +44,96 @@ type Config struct {
 	ConnectionProfiles   map[string]ConnectionProfile
 	Version              string
 	JobCompletionTimeOut int
+	// clients is shared by all copies of Config, a client is only created when clients is nil
+	clients *clientCache
+	// auditLog records the calls modifying a cluster, if set
+	auditLog *restclient.AuditLog
+}
+
+// clientCache holds one REST client per connection profile, shared by all resources and data sources.
+// This way, the HTTP connection pool, max_concurrent_requests and the cluster information apply per cluster.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[string]*restclient.RestClient
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{clients: map[string]*restclient.RestClient{}}
 }
 
 // GetConnectionProfile retrieves a connection profile based on name
 // If name is empty and only one profile is defined, it is returned
 func (c *Config) GetConnectionProfile(name string) (*ConnectionProfile, error) {
+	name, err := c.getConnectionProfileName(name)
+	if err != nil {
+		return nil, err
+	}
+	profile := c.ConnectionProfiles[name]
+	return &profile, nil
+}
+
+// getConnectionProfileName returns name if the profile is defined, or the name of the only profile if name is empty
+func (c *Config) getConnectionProfileName(name string) (string, error) {
 	if c == nil {
-		return nil, fmt.Errorf("internal error, config is not initialized")
+		return "", fmt.Errorf("internal error, config is not initialized")
 	}
 	if len(c.ConnectionProfiles) == 0 {
-		return nil, fmt.Errorf("error, at least one connection profile is required to connect to ONTAP")
+		return "", fmt.Errorf("error, at least one connection profile is required to connect to ONTAP")
 	}
 	if name == "" && len(c.ConnectionProfiles) == 1 {
 		name = maps.Keys(c.ConnectionProfiles)[0]
 	}
 	if name == "" {
-		return nil, fmt.Errorf("error, connection profile name is required if more than one profile is defined")
+		return "", fmt.Errorf("error, connection profile name is required if more than one profile is defined")
 	}
-	if profile, ok := c.ConnectionProfiles[name]; ok {
-		return &profile, nil
+	if _, ok := c.ConnectionProfiles[name]; ok {
+		return name, nil
 	}
-	return nil, fmt.Errorf("connection profile with name %s is not defined", name)
+	return "", fmt.Errorf("connection profile with name %s is not defined", name)
 }
 
-// NewClient creates a RestClient based on the connection profile identified by cxProfileName
+// NewClient returns a RestClient based on the connection profile identified by cxProfileName
+// The client is created on first use, and shared with other resources and data sources using the same profile.
 func (c *Config) NewClient(errorHandler *utils.ErrorHandler, cxProfileName string, resName string) (*restclient.RestClient, error) {
-	connectionProfile, err := c.GetConnectionProfile(cxProfileName)
+	name, err := c.getConnectionProfileName(cxProfileName)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("failed to set connection profile", err.Error())
 	}
+	// the tag resource_name/version will be used for telemetry
+	tag := strings.Join([]string{"TerraformONTAP", resName, c.Version}, "/")
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Version string is: %#v", tag))
+	if c.clients == nil {
+		return c.createClient(errorHandler, name, tag)
+	}
+	c.clients.mu.Lock()
+	defer c.clients.mu.Unlock()
+	client, ok := c.clients.clients[name]
+	if !ok {
+		client, err = c.createClient(errorHandler, name, tag)
+		if err != nil {
+			return nil, err
+		}
+		c.clients.clients[name] = client
+	}
+	return client.WithContext(errorHandler.Ctx, tag), nil
+}
+
+// createClient creates a RestClient for the connection profile name
+func (c *Config) createClient(errorHandler *utils.ErrorHandler, name string, tag string) (*restclient.RestClient, error) {
+	connectionProfile := c.ConnectionProfiles[name]
 	var profile restclient.ConnectionProfile
-	err = mapstructure.Decode(connectionProfile, &profile)
+	err := mapstructure.Decode(connectionProfile, &profile)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("unable to create REST client",
 			fmt.Sprintf("decode error on ConnectionProfile %#v to restclient.ConnectionProfile", connectionProfile))
 	}
-	// the tag resource_name/version will be used for telemetry
-
-	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Version string is: %#v", strings.Join([]string{"TerrafromONTAP", resName, c.Version}, "/")))
-	client, err := restclient.NewClient(errorHandler.Ctx, profile, strings.Join([]string{"TerraformONTAP", resName, c.Version}, "/"), c.JobCompletionTimeOut)
+	client, err := restclient.NewClient(errorHandler.Ctx, profile, tag, c.JobCompletionTimeOut)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("unable to create REST client",
 			fmt.Sprintf("error creating REST client: %s", err))
 	}
+	if c.auditLog != nil {
+		client = client.WithAuditLog(c.auditLog)
+	}
 	return client, err
 }
@@ -104,3 +104,26 @@ func TestConfig_NewClient(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_NewClient_shared(t *testing.T) {
+	c := &Config{
+		ConnectionProfiles: map[string]ConnectionProfile{"cluster1": {}},
+		Version:            "v1.2.3",
+		clients:            newClientCache(),
+	}
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	for _, name := range []string{"cluster1", "", "cluster1"} {
+		client, err := c.NewClient(errorHandler, name, "config_test")
+		if err != nil {
+			t.Errorf("Config.NewClient() error = %v", err)
+			return
+		}
+		if client == nil {
+			t.Errorf("Config.NewClient() returned nil client")
+			return
+		}
+	}
+	if len(c.clients.clients) != 1 {
+		t.Errorf("Config.NewClient() expected a single shared client, got %d", len(c.clients.clients))
+	}
+}
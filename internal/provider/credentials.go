@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envProfileName is the name of the connection profile defined with environment variables
+const envProfileName = "default"
+
+// Environment variables used to define the default connection profile, and the credentials file
+const (
+	envHostname        = "NETAPP_ONTAP_HOSTNAME"
+	envUsername        = "NETAPP_ONTAP_USERNAME"
+	envPassword        = "NETAPP_ONTAP_PASSWORD"
+	envValidateCerts   = "NETAPP_ONTAP_VALIDATE_CERTS"
+	envCredentialsFile = "NETAPP_ONTAP_CREDENTIALS_FILE"
+)
+
+// credentialsFileModel describes the content of a credentials file, in YAML or JSON
+type credentialsFileModel struct {
+	ConnectionProfiles []credentialsFileProfileModel `yaml:"connection_profiles"`
+}
+
+// credentialsFileProfileModel uses the same names as the connection_profiles attributes
+type credentialsFileProfileModel struct {
+	Name                   string                     `yaml:"name"`
+	Hostname               string                     `yaml:"hostname"`
+	Port                   int                        `yaml:"port"`
+	Scheme                 string                     `yaml:"scheme"`
+	APIRoot                string                     `yaml:"api_root"`
+	ProxyURL               string                     `yaml:"proxy_url"`
+	Username               string                     `yaml:"username"`
+	Password               string                     `yaml:"password"`
+	ValidateCerts          *bool                      `yaml:"validate_certs"`
+	ClientCertificate      string                     `yaml:"client_certificate"`
+	ClientKey              string                     `yaml:"client_key"`
+	CACertificate          string                     `yaml:"ca_certificate"`
+	TLSServerName          string                     `yaml:"tls_server_name"`
+	CertificateFingerprint string                     `yaml:"certificate_fingerprint"`
+	MinTLSVersion          string                     `yaml:"min_tls_version"`
+	ConnectTimeout         int                        `yaml:"connect_timeout"`
+	TLSHandshakeTimeout    int                        `yaml:"tls_handshake_timeout"`
+	ResponseTimeout        int                        `yaml:"response_timeout"`
+	ReturnTimeout          int                        `yaml:"return_timeout"`
+	MaxConcurrentRequests  int                        `yaml:"max_concurrent_requests"`
+	PageSize               int                        `yaml:"page_size"`
+	RecordLimit            int                        `yaml:"record_limit"`
+	Retry                  *credentialsFileRetryModel `yaml:"retry"`
+}
+
+// credentialsFileRetryModel uses the same names as the retry attributes, times are in seconds
+type credentialsFileRetryModel struct {
+	MaxAttempts          int      `yaml:"max_attempts"`
+	InitialBackoff       int      `yaml:"initial_backoff"`
+	MaxBackoff           int      `yaml:"max_backoff"`
+	RetryableStatusCodes []int    `yaml:"retryable_status_codes"`
+	RetryableErrorCodes  []string `yaml:"retryable_error_codes"`
+	RetryNonIdempotent   bool     `yaml:"retry_non_idempotent"`
+}
+
+// connectionProfileFromEnv returns the default connection profile if NETAPP_ONTAP_HOSTNAME is set, or nil
+func connectionProfileFromEnv() (*ConnectionProfile, error) {
+	hostname := os.Getenv(envHostname)
+	if hostname == "" {
+		return nil, nil
+	}
+	validateCerts := true
+	if value := os.Getenv(envValidateCerts); value != "" {
+		var err error
+		if validateCerts, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("%s is not a valid boolean: %s", envValidateCerts, value)
+		}
+	}
+	return &ConnectionProfile{
+		Hostname:      hostname,
+		Username:      os.Getenv(envUsername),
+		Password:      os.Getenv(envPassword),
+		ValidateCerts: validateCerts,
+	}, nil
+}
+
+// readCredentialsFile returns the connection profiles defined in fileName, by name.
+// JSON is a subset of YAML, so both formats are supported.
+func readCredentialsFile(fileName string) (map[string]ConnectionProfile, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read credentials file: %s", err)
+	}
+	var credentials credentialsFileModel
+	if err := yaml.Unmarshal(content, &credentials); err != nil {
+		return nil, fmt.Errorf("unable to parse credentials file %s: %s", fileName, err)
+	}
+	profiles := make(map[string]ConnectionProfile, len(credentials.ConnectionProfiles))
+	for index, profile := range credentials.ConnectionProfiles {
+		if profile.Name == "" || profile.Hostname == "" {
+			return nil, fmt.Errorf("name and hostname are required for connection profile %d in credentials file %s", index, fileName)
+		}
+		if _, ok := profiles[profile.Name]; ok {
+			return nil, fmt.Errorf("connection profile %s is defined more than once in credentials file %s", profile.Name, fileName)
+		}
+		if profile.MaxConcurrentRequests < 0 {
+			return nil, fmt.Errorf("max_concurrent_requests cannot be negative for connection profile %s in credentials file %s", profile.Name, fileName)
+		}
+		validateCerts := true
+		if profile.ValidateCerts != nil {
+			validateCerts = *profile.ValidateCerts
+		}
+		profiles[profile.Name] = ConnectionProfile{
+			Hostname:               profile.Hostname,
+			Port:                   profile.Port,
+			Scheme:                 profile.Scheme,
+			APIRoot:                profile.APIRoot,
+			ProxyURL:               profile.ProxyURL,
+			Username:               profile.Username,
+			Password:               profile.Password,
+			ValidateCerts:          validateCerts,
+			ClientCertificate:      profile.ClientCertificate,
+			ClientKey:              profile.ClientKey,
+			CACertificate:          profile.CACertificate,
+			TLSServerName:          profile.TLSServerName,
+			CertificateFingerprint: profile.CertificateFingerprint,
+			MinTLSVersion:          profile.MinTLSVersion,
+			ConnectTimeout:         time.Duration(profile.ConnectTimeout) * time.Second,
+			TLSHandshakeTimeout:    time.Duration(profile.TLSHandshakeTimeout) * time.Second,
+			ResponseTimeout:        time.Duration(profile.ResponseTimeout) * time.Second,
+			ReturnTimeout:          profile.ReturnTimeout,
+			MaxConcurrentRequests:  profile.MaxConcurrentRequests,
+			PageSize:               profile.PageSize,
+			RecordLimit:            profile.RecordLimit,
+			Retry:                  profile.Retry.toRetryPolicy(),
+		}
+	}
+	return profiles, nil
+}
+
+// toRetryPolicy converts the retry block, unset values are replaced with defaults when creating the REST client.
+func (m *credentialsFileRetryModel) toRetryPolicy() restclient.RetryPolicy {
+	if m == nil {
+		return restclient.RetryPolicy{}
+	}
+	return restclient.RetryPolicy{
+		MaxAttempts:          m.MaxAttempts,
+		InitialBackoff:       time.Duration(m.InitialBackoff) * time.Second,
+		MaxBackoff:           time.Duration(m.MaxBackoff) * time.Second,
+		RetryableStatusCodes: m.RetryableStatusCodes,
+		RetryableErrorCodes:  m.RetryableErrorCodes,
+		RetryNonIdempotent:   m.RetryNonIdempotent,
+	}
+}
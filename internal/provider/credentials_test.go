@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+)
+
+func TestConnectionProfileFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    *ConnectionProfile
+		wantErr bool
+	}{
+		{name: "test_not_set", env: map[string]string{}, want: nil, wantErr: false},
+		{name: "test_default_validate_certs", env: map[string]string{envHostname: "10.10.10.10", envUsername: "admin", envPassword: "secret"},
+			want: &ConnectionProfile{Hostname: "10.10.10.10", Username: "admin", Password: "secret", ValidateCerts: true}, wantErr: false},
+		{name: "test_validate_certs", env: map[string]string{envHostname: "10.10.10.10", envUsername: "admin", envPassword: "secret", envValidateCerts: "false"},
+			want: &ConnectionProfile{Hostname: "10.10.10.10", Username: "admin", Password: "secret", ValidateCerts: false}, wantErr: false},
+		{name: "test_invalid_validate_certs", env: map[string]string{envHostname: "10.10.10.10", envValidateCerts: "maybe"}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{envHostname, envUsername, envPassword, envValidateCerts} {
+				t.Setenv(name, tt.env[name])
+			}
+			got, err := connectionProfileFromEnv()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("connectionProfileFromEnv() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("connectionProfileFromEnv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCredentialsFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"profiles.yaml": `
+connection_profiles:
+  - name: cluster1
+    hostname: 10.10.10.10
+    username: admin
+    password: secret
+    validate_certs: false
+  - name: cluster2
+    hostname: 10.10.10.11
+    client_certificate: admin.pem
+    client_key: admin.key
+  - name: cluster3
+    hostname: bastion.example.com
+    port: 8443
+    scheme: https
+    api_root: ontap3/api
+    proxy_url: http://proxy.example.com:3128
+    username: admin
+    password: secret
+  - name: cluster4
+    hostname: 10.10.10.12
+    username: admin
+    password: secret
+    connect_timeout: 5
+    tls_handshake_timeout: 6
+    response_timeout: 300
+    return_timeout: 30
+    max_concurrent_requests: 2
+    page_size: 100
+    record_limit: 1000
+    retry:
+      max_attempts: 5
+      initial_backoff: 2
+      max_backoff: 60
+      retryable_status_codes: [503]
+      retryable_error_codes: [13303812, "917927"]
+      retry_non_idempotent: true
+`,
+		"profiles.json":  `{"connection_profiles": [{"name": "cluster1", "hostname": "10.10.10.10", "username": "admin", "password": "secret", "validate_certs": false}]}`,
+		"no_name.yaml":   "connection_profiles:\n  - hostname: 10.10.10.10\n",
+		"duplicate.yaml": "connection_profiles:\n  - name: cluster1\n    hostname: 10.10.10.10\n  - name: cluster1\n    hostname: 10.10.10.11\n",
+		"invalid.json":   `{"connection_profiles": [`,
+		"negative.yaml":  "connection_profiles:\n  - name: cluster1\n    hostname: 10.10.10.10\n    max_concurrent_requests: -1\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cluster1 := ConnectionProfile{Hostname: "10.10.10.10", Username: "admin", Password: "secret", ValidateCerts: false}
+	cluster2 := ConnectionProfile{Hostname: "10.10.10.11", ClientCertificate: "admin.pem", ClientKey: "admin.key", ValidateCerts: true}
+	cluster3 := ConnectionProfile{Hostname: "bastion.example.com", Port: 8443, Scheme: "https", APIRoot: "ontap3/api", ProxyURL: "http://proxy.example.com:3128", Username: "admin", Password: "secret", ValidateCerts: true}
+	cluster4 := ConnectionProfile{Hostname: "10.10.10.12", Username: "admin", Password: "secret", ValidateCerts: true,
+		ConnectTimeout: 5 * time.Second, TLSHandshakeTimeout: 6 * time.Second, ResponseTimeout: 300 * time.Second, ReturnTimeout: 30,
+		MaxConcurrentRequests: 2, PageSize: 100, RecordLimit: 1000,
+		Retry: restclient.RetryPolicy{MaxAttempts: 5, InitialBackoff: 2 * time.Second, MaxBackoff: 60 * time.Second,
+			RetryableStatusCodes: []int{503}, RetryableErrorCodes: []string{"13303812", "917927"}, RetryNonIdempotent: true}}
+	tests := []struct {
+		name     string
+		fileName string
+		want     map[string]ConnectionProfile
+		wantErr  bool
+	}{
+		{name: "test_yaml", fileName: "profiles.yaml", want: map[string]ConnectionProfile{"cluster1": cluster1, "cluster2": cluster2, "cluster3": cluster3, "cluster4": cluster4}, wantErr: false},
+		{name: "test_json", fileName: "profiles.json", want: map[string]ConnectionProfile{"cluster1": cluster1}, wantErr: false},
+		{name: "test_no_name", fileName: "no_name.yaml", want: nil, wantErr: true},
+		{name: "test_duplicate", fileName: "duplicate.yaml", want: nil, wantErr: true},
+		{name: "test_invalid", fileName: "invalid.json", want: nil, wantErr: true},
+		{name: "test_negative", fileName: "negative.yaml", want: nil, wantErr: true},
+		{name: "test_missing", fileName: "missing.yaml", want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readCredentialsFile(filepath.Join(dir, tt.fileName))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("readCredentialsFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readCredentialsFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
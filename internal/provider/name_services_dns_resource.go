@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -127,7 +126,7 @@ func (r *NameServicesDNSResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No DNS found", fmt.Sprintf("NO DNS on svm %s found.", data.SVMName.ValueString()))
+		removeResourceNotFound(ctx, resp, "DNS configuration for svm", data.SVMName.ValueString())
 		return
 	}
 
@@ -247,13 +246,8 @@ func (r *NameServicesDNSResource) Delete(ctx context.Context, req resource.Delet
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *NameServicesDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: attr_one,attr_two. Got: %q", req.ID),
-		)
+	idParts, ok := splitImportID(req.ID, "svm_name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
@@ -9,6 +9,7 @@ import (
 )
 
 func TestAccNameServicesDNSResource(t *testing.T) {
+	testAccReplay(t)
 	svmName := "ansibleSVM"
 	credName := "cluster4"
 	resource.Test(t, resource.TestCase{
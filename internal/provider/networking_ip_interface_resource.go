@@ -168,7 +168,7 @@ func (r *IPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No Interface found", fmt.Sprintf("NO interface, %s found.", data.Name.ValueString()))
+		removeResourceNotFound(ctx, resp, "ip interface", data.Name.ValueString())
 		return
 	}
 	data.Name = types.StringValue(restInfo.Name)
@@ -125,7 +125,7 @@ func (d *IPRouteDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
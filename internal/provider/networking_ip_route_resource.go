@@ -157,7 +157,7 @@ func (r *IPRouteResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -172,7 +172,7 @@ func (r *IPRouteResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No IP Route found", fmt.Sprintf("No IP Route %s found", data.Destination.Address.ValueString()))
+		removeResourceNotFound(ctx, resp, "ip route", data.Destination.Address.ValueString())
 		return
 	}
 
@@ -9,6 +9,7 @@ import (
 )
 
 func TestAccNetworkingIpRouteResource(t *testing.T) {
+	testAccReplay(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
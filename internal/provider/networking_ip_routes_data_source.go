@@ -180,7 +180,7 @@ func (d *IPRoutesDataSource) Read(ctx context.Context, req datasource.ReadReques
 		}
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
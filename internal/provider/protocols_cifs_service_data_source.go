@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCifsServiceDataSource{}
+
+// NewProtocolsCifsServiceDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsServiceDataSource() datasource.DataSource {
+	return &ProtocolsCifsServiceDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_service_data_source",
+		},
+	}
+}
+
+// ProtocolsCifsServiceDataSource defines the data source implementation.
+type ProtocolsCifsServiceDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsServiceDataSourceModel describes the data source data model.
+type ProtocolsCifsServiceDataSourceModel struct {
+	CxProfileName   types.String                        `tfsdk:"cx_profile_name"`
+	SVMName         types.String                        `tfsdk:"svm_name"`
+	Name            types.String                        `tfsdk:"name"`
+	Enabled         types.Bool                          `tfsdk:"enabled"`
+	Comment         types.String                        `tfsdk:"comment"`
+	DefaultUnixUser types.String                        `tfsdk:"default_unix_user"`
+	AdDomain        *CifsServiceAdDomainDataSourceModel `tfsdk:"ad_domain"`
+	Netbios         *CifsServiceNetbiosDataSourceModel  `tfsdk:"netbios"`
+	Security        *CifsServiceSecurityDataSourceModel `tfsdk:"security"`
+}
+
+// CifsServiceAdDomainDataSourceModel describes the Active Directory domain of the CIFS server
+type CifsServiceAdDomainDataSourceModel struct {
+	Fqdn               types.String `tfsdk:"fqdn"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+}
+
+// CifsServiceNetbiosDataSourceModel describes the NetBIOS settings of the CIFS server
+type CifsServiceNetbiosDataSourceModel struct {
+	Aliases     []types.String `tfsdk:"aliases"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	WinsServers []types.String `tfsdk:"wins_servers"`
+}
+
+// CifsServiceSecurityDataSourceModel describes the security settings of the CIFS server
+type CifsServiceSecurityDataSourceModel struct {
+	SmbSigning           types.Bool   `tfsdk:"smb_signing"`
+	SmbEncryption        types.Bool   `tfsdk:"smb_encryption"`
+	EncryptDcConnection  types.Bool   `tfsdk:"encrypt_dc_connection"`
+	AesNetlogonEnabled   types.Bool   `tfsdk:"aes_netlogon_enabled"`
+	RestrictAnonymous    types.String `tfsdk:"restrict_anonymous"`
+	LmCompatibilityLevel types.String `tfsdk:"lm_compatibility_level"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCifsServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCifsServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsService data source",
+
+		Attributes: protocolsCifsServiceDataSourceAttributes(true),
+	}
+}
+
+// protocolsCifsServiceDataSourceAttributes returns the attributes of a CIFS server.
+// cx_profile_name and svm_name are required to read a single server, and computed in a list of servers.
+func protocolsCifsServiceDataSourceAttributes(single bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cx_profile_name": schema.StringAttribute{
+			MarkdownDescription: "Connection profile name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"svm_name": schema.StringAttribute{
+			MarkdownDescription: "ProtocolsCifsService svm name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "NetBIOS name of the CIFS server",
+			Computed:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the CIFS server is administratively enabled",
+			Computed:            true,
+		},
+		"comment": schema.StringAttribute{
+			MarkdownDescription: "Text comment about the CIFS server",
+			Computed:            true,
+		},
+		"default_unix_user": schema.StringAttribute{
+			MarkdownDescription: "Default UNIX user for SMB access",
+			Computed:            true,
+		},
+		"ad_domain": schema.SingleNestedAttribute{
+			MarkdownDescription: "Active Directory domain",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"fqdn": schema.StringAttribute{
+					MarkdownDescription: "Fully qualified domain name of the Active Directory domain",
+					Computed:            true,
+				},
+				"organizational_unit": schema.StringAttribute{
+					MarkdownDescription: "Organizational unit of the machine account",
+					Computed:            true,
+				},
+			},
+		},
+		"netbios": schema.SingleNestedAttribute{
+			MarkdownDescription: "NetBIOS settings",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"aliases": schema.SetAttribute{
+					ElementType:         types.StringType,
+					MarkdownDescription: "NetBIOS aliases of the CIFS server",
+					Computed:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether NetBIOS name service is enabled",
+					Computed:            true,
+				},
+				"wins_servers": schema.SetAttribute{
+					ElementType:         types.StringType,
+					MarkdownDescription: "IPv4 addresses of the WINS servers",
+					Computed:            true,
+				},
+			},
+		},
+		"security": schema.SingleNestedAttribute{
+			MarkdownDescription: "Security settings",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"smb_signing": schema.BoolAttribute{
+					MarkdownDescription: "Whether signing is required for incoming SMB traffic",
+					Computed:            true,
+				},
+				"smb_encryption": schema.BoolAttribute{
+					MarkdownDescription: "Whether encryption is required for incoming SMB traffic",
+					Computed:            true,
+				},
+				"encrypt_dc_connection": schema.BoolAttribute{
+					MarkdownDescription: "Whether the connections to the domain controllers are encrypted",
+					Computed:            true,
+				},
+				"aes_netlogon_enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether AES session keys are used for the Netlogon channel",
+					Computed:            true,
+				},
+				"restrict_anonymous": schema.StringAttribute{
+					MarkdownDescription: "Restrictions for anonymous users",
+					Computed:            true,
+				},
+				"lm_compatibility_level": schema.StringAttribute{
+					MarkdownDescription: "Authentication protocols accepted from clients",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCifsServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCifsServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCifsServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS service found", fmt.Sprintf("CIFS service for svm %s not found.", data.SVMName.ValueString()))
+		return
+	}
+	data = protocolsCifsServiceDataSourceModel(data.CxProfileName, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// protocolsCifsServiceDataSourceModel converts a CIFS server returned by ONTAP
+func protocolsCifsServiceDataSourceModel(cxProfileName types.String, record *interfaces.ProtocolsCifsServiceGetDataModelONTAP) ProtocolsCifsServiceDataSourceModel {
+	return ProtocolsCifsServiceDataSourceModel{
+		CxProfileName:   cxProfileName,
+		SVMName:         types.StringValue(record.SVM.Name),
+		Name:            types.StringValue(record.Name),
+		Enabled:         types.BoolValue(record.Enabled),
+		Comment:         types.StringValue(record.Comment),
+		DefaultUnixUser: types.StringValue(record.DefaultUnixUser),
+		AdDomain: &CifsServiceAdDomainDataSourceModel{
+			Fqdn:               types.StringValue(record.AdDomain.Fqdn),
+			OrganizationalUnit: types.StringValue(record.AdDomain.OrganizationalUnit),
+		},
+		Netbios: &CifsServiceNetbiosDataSourceModel{
+			Aliases:     flattenTypesStringList(record.Netbios.Aliases),
+			Enabled:     types.BoolValue(record.Netbios.Enabled),
+			WinsServers: flattenTypesStringList(record.Netbios.WinsServers),
+		},
+		Security: &CifsServiceSecurityDataSourceModel{
+			SmbSigning:           types.BoolValue(record.Security.SmbSigning),
+			SmbEncryption:        types.BoolValue(record.Security.SmbEncryption),
+			EncryptDcConnection:  types.BoolValue(record.Security.EncryptDcConnection),
+			AesNetlogonEnabled:   types.BoolValue(record.Security.AesNetlogonEnabled),
+			RestrictAnonymous:    types.StringValue(record.Security.RestrictAnonymous),
+			LmCompatibilityLevel: types.StringValue(record.Security.LmCompatibilityLevel),
+		},
+	}
+}
@@ -0,0 +1,562 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsCifsServiceResource{}
+var _ resource.ResourceWithImportState = &ProtocolsCifsServiceResource{}
+var _ resource.ResourceWithModifyPlan = &ProtocolsCifsServiceResource{}
+
+// NewProtocolsCifsServiceResource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsServiceResource() resource.Resource {
+	return &ProtocolsCifsServiceResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_service_resource",
+		},
+	}
+}
+
+// ProtocolsCifsServiceResource defines the resource implementation.
+type ProtocolsCifsServiceResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsServiceResourceModel describes the resource data model.
+type ProtocolsCifsServiceResourceModel struct {
+	CxProfileName   types.String                      `tfsdk:"cx_profile_name"`
+	SVMName         types.String                      `tfsdk:"svm_name"`
+	Name            types.String                      `tfsdk:"name"`
+	Enabled         types.Bool                        `tfsdk:"enabled"`
+	Comment         types.String                      `tfsdk:"comment"`
+	DefaultUnixUser types.String                      `tfsdk:"default_unix_user"`
+	AdDomain        *CifsServiceAdDomainResourceModel `tfsdk:"ad_domain"`
+	Netbios         *CifsServiceNetbiosResourceModel  `tfsdk:"netbios"`
+	Security        *CifsServiceSecurityResourceModel `tfsdk:"security"`
+	ID              types.String                      `tfsdk:"id"`
+	Timeouts        timeouts.Value                    `tfsdk:"timeouts"`
+}
+
+// CifsServiceAdDomainResourceModel describes the Active Directory domain of the CIFS server
+type CifsServiceAdDomainResourceModel struct {
+	Fqdn               types.String `tfsdk:"fqdn"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	User               types.String `tfsdk:"user"`
+	Password           types.String `tfsdk:"password"`
+}
+
+// CifsServiceNetbiosResourceModel describes the NetBIOS settings of the CIFS server
+type CifsServiceNetbiosResourceModel struct {
+	Aliases     []types.String `tfsdk:"aliases"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	WinsServers []types.String `tfsdk:"wins_servers"`
+}
+
+// CifsServiceSecurityResourceModel describes the security settings of the CIFS server
+type CifsServiceSecurityResourceModel struct {
+	SmbSigning           types.Bool   `tfsdk:"smb_signing"`
+	SmbEncryption        types.Bool   `tfsdk:"smb_encryption"`
+	EncryptDcConnection  types.Bool   `tfsdk:"encrypt_dc_connection"`
+	AesNetlogonEnabled   types.Bool   `tfsdk:"aes_netlogon_enabled"`
+	RestrictAnonymous    types.String `tfsdk:"restrict_anonymous"`
+	LmCompatibilityLevel types.String `tfsdk:"lm_compatibility_level"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsCifsServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsCifsServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		}
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsService resource, a CIFS server joined to an Active Directory domain",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "ProtocolsCifsService svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "NetBIOS name of the CIFS server, used as the machine account in the domain. The server is disabled while it is renamed",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 15)},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the CIFS server is administratively enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Text comment of up to 48 characters about the CIFS server",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"default_unix_user": schema.StringAttribute{
+				MarkdownDescription: "Default UNIX user for SMB access",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ad_domain": schema.SingleNestedAttribute{
+				MarkdownDescription: "Active Directory domain to join. The credentials are also used to rejoin the domain, and to remove the machine account on destroy",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"fqdn": schema.StringAttribute{
+						MarkdownDescription: "Fully qualified domain name of the Active Directory domain",
+						Required:            true,
+					},
+					"organizational_unit": schema.StringAttribute{
+						MarkdownDescription: "Organizational unit for the machine account, relative to the domain, eg CN=Computers",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "Account with the privileges to add or remove the machine account in the domain",
+						Required:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password of the account",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"netbios": schema.SingleNestedAttribute{
+				MarkdownDescription: "NetBIOS settings",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"aliases": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "NetBIOS aliases of the CIFS server",
+						Optional:            true,
+					},
+					"enabled": computedBool("Whether NetBIOS name service is enabled"),
+					"wins_servers": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "IPv4 addresses of the WINS servers",
+						Optional:            true,
+					},
+				},
+			},
+			"security": schema.SingleNestedAttribute{
+				MarkdownDescription: "Security settings",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"smb_signing":           computedBool("Whether signing is required for incoming SMB traffic"),
+					"smb_encryption":        computedBool("Whether encryption is required for incoming SMB traffic"),
+					"encrypt_dc_connection": computedBool("Whether the connections to the domain controllers are encrypted. Requires ONTAP 9.8 or later"),
+					"aes_netlogon_enabled":  computedBool("Whether AES session keys are used for the Netlogon channel. Requires ONTAP 9.10.1 or later"),
+					"restrict_anonymous": schema.StringAttribute{
+						MarkdownDescription: "Restrictions for anonymous users, no_restriction, no_enumeration or no_access",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						Validators:          []validator.String{stringvalidator.OneOf("no_restriction", "no_enumeration", "no_access")},
+					},
+					"lm_compatibility_level": schema.StringAttribute{
+						MarkdownDescription: "Authentication protocols accepted from clients, lm_ntlm_ntlmv2_krb, ntlm_ntlmv2_krb, ntlmv2_krb or krb",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						Validators:          []validator.String{stringvalidator.OneOf("lm_ntlm_ntlmv2_krb", "ntlm_ntlmv2_krb", "ntlmv2_krb", "krb")},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the svm",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsCifsServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ModifyPlan reports attributes that are not supported by the ONTAP version of the cluster, before any change is made.
+func (r *ProtocolsCifsServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config *ProtocolsCifsServiceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	// config is null when the resource is destroyed
+	if config == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	requirements := map[string]string{}
+	if config.Security != nil && !config.Security.EncryptDcConnection.IsNull() {
+		requirements["attribute security.encrypt_dc_connection"] = "9.8.0"
+	}
+	if config.Security != nil && !config.Security.AesNetlogonEnabled.IsNull() {
+		requirements["attribute security.aes_netlogon_enabled"] = "9.10.1"
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkONTAPVersion(errorHandler, r.config, config.CxProfileName, requirements)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsCifsServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProtocolsCifsServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsService
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "CIFS service for svm", data.SVMName.ValueString())
+		return
+	}
+	setProtocolsCifsServiceResourceModel(data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsCifsServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsCifsServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSensitiveValues(ctx, []types.String{data.AdDomain.Password})
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := protocolsCifsServiceBody(data)
+	body.Name = data.Name.ValueString()
+	body.SVM = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	body.AdDomain = cifsServiceAdDomainBody(data.AdDomain)
+	err = interfaces.CreateProtocolsCifsService(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the values set by ONTAP, eg the organizational unit
+	restInfo, err := interfaces.GetProtocolsCifsService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS service found", fmt.Sprintf("CIFS service for svm %s not found after create.", data.SVMName.ValueString()))
+		return
+	}
+	setProtocolsCifsServiceResourceModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsCifsServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ProtocolsCifsServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read state file data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSensitiveValues(ctx, []types.String{data.AdDomain.Password, state.AdDomain.Password})
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := protocolsCifsServiceBody(data)
+	// the credentials are only used to rejoin the domain, changing them alone does not require a change on the cluster
+	renamed := !strings.EqualFold(data.Name.ValueString(), state.Name.ValueString())
+	rejoin := renamed || !strings.EqualFold(data.AdDomain.Fqdn.ValueString(), state.AdDomain.Fqdn.ValueString()) ||
+		!data.AdDomain.OrganizationalUnit.IsUnknown() && !strings.EqualFold(data.AdDomain.OrganizationalUnit.ValueString(), state.AdDomain.OrganizationalUnit.ValueString())
+	if rejoin {
+		if renamed {
+			body.Name = data.Name.ValueString()
+		}
+		body.AdDomain = cifsServiceAdDomainBody(data.AdDomain)
+		err = updateCifsServiceWithRejoin(errorHandler, *client, body, state.Enabled.ValueBool(), state.ID.ValueString())
+	} else {
+		err = interfaces.UpdateProtocolsCifsService(errorHandler, *client, body, state.ID.ValueString())
+	}
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS service found", fmt.Sprintf("CIFS service for svm %s not found after update.", data.SVMName.ValueString()))
+		return
+	}
+	setProtocolsCifsServiceResourceModel(data, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The machine account is removed from the domain using the ad_domain credentials.
+func (r *ProtocolsCifsServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsCifsServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSensitiveValues(ctx, []types.String{data.AdDomain.Password})
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("ID is null", "svm UUID is null")
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	err = interfaces.DeleteProtocolsCifsService(errorHandler, *client, data.ID.ValueString(), *cifsServiceAdDomainBody(data.AdDomain))
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+// ad_domain.user and ad_domain.password are not returned by ONTAP, and are set from the configuration on the next apply.
+func (r *ProtocolsCifsServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
+
+// protocolsCifsServiceBody returns the settings shared by POST and PATCH.  Unknown values are left for ONTAP to set.
+func protocolsCifsServiceBody(data *ProtocolsCifsServiceResourceModel) interfaces.ProtocolsCifsServiceResourceBodyDataModelONTAP {
+	var body interfaces.ProtocolsCifsServiceResourceBodyDataModelONTAP
+	body.Enabled = knownBool(data.Enabled)
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	if !data.DefaultUnixUser.IsUnknown() {
+		body.DefaultUnixUser = data.DefaultUnixUser.ValueString()
+	}
+	if data.Netbios != nil {
+		body.Netbios = &interfaces.CifsServiceNetbiosBody{
+			Aliases:     stringValues(data.Netbios.Aliases),
+			Enabled:     knownBool(data.Netbios.Enabled),
+			WinsServers: stringValues(data.Netbios.WinsServers),
+		}
+	}
+	if data.Security != nil {
+		body.Security = &interfaces.CifsServiceSecurityBody{
+			SmbSigning:          knownBool(data.Security.SmbSigning),
+			SmbEncryption:       knownBool(data.Security.SmbEncryption),
+			EncryptDcConnection: knownBool(data.Security.EncryptDcConnection),
+			AesNetlogonEnabled:  knownBool(data.Security.AesNetlogonEnabled),
+		}
+		if !data.Security.RestrictAnonymous.IsUnknown() {
+			body.Security.RestrictAnonymous = data.Security.RestrictAnonymous.ValueString()
+		}
+		if !data.Security.LmCompatibilityLevel.IsUnknown() {
+			body.Security.LmCompatibilityLevel = data.Security.LmCompatibilityLevel.ValueString()
+		}
+	}
+	return body
+}
+
+// updateCifsServiceWithRejoin renames the server, or moves it to another domain or organizational unit.
+// ONTAP only does this for a server that is administratively disabled: the server is disabled first, the changes are
+// sent with enabled set to false, and the server is enabled again in a separate PATCH when it is expected to be enabled.
+func updateCifsServiceWithRejoin(errorHandler *utils.ErrorHandler, r restclient.RestClient, body interfaces.ProtocolsCifsServiceResourceBodyDataModelONTAP, wasEnabled bool, svmUUID string) error {
+	enabled := wasEnabled
+	if body.Enabled != nil {
+		enabled = *body.Enabled
+	}
+	disabled := false
+	if wasEnabled {
+		err := interfaces.UpdateProtocolsCifsService(errorHandler, r, interfaces.ProtocolsCifsServiceResourceBodyDataModelONTAP{Enabled: &disabled}, svmUUID)
+		if err != nil {
+			return err
+		}
+	}
+	body.Enabled = &disabled
+	if err := interfaces.UpdateProtocolsCifsService(errorHandler, r, body, svmUUID); err != nil {
+		return err
+	}
+	if enabled {
+		return interfaces.UpdateProtocolsCifsService(errorHandler, r, interfaces.ProtocolsCifsServiceResourceBodyDataModelONTAP{Enabled: &enabled}, svmUUID)
+	}
+	return nil
+}
+
+// cifsServiceAdDomainBody returns the domain and the credentials used to join or leave it
+func cifsServiceAdDomainBody(adDomain *CifsServiceAdDomainResourceModel) *interfaces.CifsServiceAdDomain {
+	if adDomain == nil {
+		return &interfaces.CifsServiceAdDomain{}
+	}
+	body := interfaces.CifsServiceAdDomain{
+		Fqdn:     adDomain.Fqdn.ValueString(),
+		User:     adDomain.User.ValueString(),
+		Password: adDomain.Password.ValueString(),
+	}
+	if !adDomain.OrganizationalUnit.IsUnknown() {
+		body.OrganizationalUnit = adDomain.OrganizationalUnit.ValueString()
+	}
+	return &body
+}
+
+// setProtocolsCifsServiceResourceModel updates data with the values returned by ONTAP.
+// ONTAP returns names in uppercase, the configured value is kept when they only differ by case.
+// The credentials are not returned, and netbios and security are only read when they are configured.
+func setProtocolsCifsServiceResourceModel(data *ProtocolsCifsServiceResourceModel, restInfo *interfaces.ProtocolsCifsServiceGetDataModelONTAP) {
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Name = equalFoldStringValue(data.Name, restInfo.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.DefaultUnixUser = types.StringValue(restInfo.DefaultUnixUser)
+	if data.AdDomain == nil {
+		data.AdDomain = &CifsServiceAdDomainResourceModel{User: types.StringNull(), Password: types.StringNull()}
+	}
+	data.AdDomain.Fqdn = equalFoldStringValue(data.AdDomain.Fqdn, restInfo.AdDomain.Fqdn)
+	data.AdDomain.OrganizationalUnit = equalFoldStringValue(data.AdDomain.OrganizationalUnit, restInfo.AdDomain.OrganizationalUnit)
+	if data.Netbios != nil {
+		if !equalFoldStringSets(data.Netbios.Aliases, restInfo.Netbios.Aliases) {
+			data.Netbios.Aliases = flattenTypesStringList(restInfo.Netbios.Aliases)
+		}
+		data.Netbios.Enabled = types.BoolValue(restInfo.Netbios.Enabled)
+		data.Netbios.WinsServers = flattenTypesStringList(restInfo.Netbios.WinsServers)
+	}
+	if data.Security != nil {
+		data.Security.SmbSigning = types.BoolValue(restInfo.Security.SmbSigning)
+		data.Security.SmbEncryption = types.BoolValue(restInfo.Security.SmbEncryption)
+		data.Security.EncryptDcConnection = types.BoolValue(restInfo.Security.EncryptDcConnection)
+		data.Security.AesNetlogonEnabled = types.BoolValue(restInfo.Security.AesNetlogonEnabled)
+		data.Security.RestrictAnonymous = types.StringValue(restInfo.Security.RestrictAnonymous)
+		data.Security.LmCompatibilityLevel = types.StringValue(restInfo.Security.LmCompatibilityLevel)
+	}
+}
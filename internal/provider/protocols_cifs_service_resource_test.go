@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestAccCifsServiceResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccCifsServiceResourceConfig("non-existant", "created by terraform"),
+				ExpectError: regexp.MustCompile("error creating CIFS service"),
+			},
+			// Create and read
+			{
+				Config: testAccCifsServiceResourceConfig("carchi-test", "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_service_resource.example", "svm_name", "carchi-test"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_service_resource.example", "name", "tfcifs"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_service_resource.example", "comment", "created by terraform"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_service_resource.example", "security.smb_signing", "true"),
+				),
+			},
+			// update and read
+			{
+				Config: testAccCifsServiceResourceConfig("carchi-test", "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_service_resource.example", "comment", "updated by terraform"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_service_resource.example", "netbios.aliases.#", "1"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:            "netapp-ontap_protocols_cifs_service_resource.example",
+				ImportState:             true,
+				ImportStateId:           "carchi-test,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ad_domain.user", "ad_domain.password", "name", "ad_domain.fqdn", "netbios.aliases"},
+			},
+		},
+	})
+}
+
+func TestUpdateCifsServiceWithRejoin(t *testing.T) {
+	enabled := true
+	disabled := false
+	patch := func(body map[string]any) restclient.MockResponse {
+		return restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/services/1234", ExpectedBody: body, StatusCode: 200, Response: restclient.RestResponse{}}
+	}
+	tests := []struct {
+		name       string
+		enabled    *bool
+		wasEnabled bool
+		responses  []restclient.MockResponse
+	}{
+		{name: "test_enabled", enabled: &enabled, wasEnabled: true, responses: []restclient.MockResponse{
+			patch(map[string]any{"enabled": false}),
+			patch(map[string]any{"name": "tfcifs2", "enabled": false}),
+			patch(map[string]any{"enabled": true}),
+		}},
+		{name: "test_enabled_unknown", enabled: nil, wasEnabled: true, responses: []restclient.MockResponse{
+			patch(map[string]any{"enabled": false}),
+			patch(map[string]any{"name": "tfcifs2", "enabled": false}),
+			patch(map[string]any{"enabled": true}),
+		}},
+		{name: "test_enable", enabled: &enabled, wasEnabled: false, responses: []restclient.MockResponse{
+			patch(map[string]any{"name": "tfcifs2", "enabled": false}),
+			patch(map[string]any{"enabled": true}),
+		}},
+		{name: "test_disable", enabled: &disabled, wasEnabled: true, responses: []restclient.MockResponse{
+			patch(map[string]any{"enabled": false}),
+			patch(map[string]any{"name": "tfcifs2", "enabled": false}),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+			r, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := interfaces.ProtocolsCifsServiceResourceBodyDataModelONTAP{Name: "tfcifs2", Enabled: tt.enabled}
+			if err := updateCifsServiceWithRejoin(errorHandler, *r, body, tt.wasEnabled, "1234"); err != nil {
+				t.Errorf("updateCifsServiceWithRejoin() error = %v", err)
+			}
+		})
+	}
+}
+
+func testAccCifsServiceResourceConfig(svmName, comment string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	adPassword := os.Getenv("TF_ACC_NETAPP_AD_PASS")
+	if host == "" || admin == "" || password == "" || adPassword == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, TF_ACC_NETAPP_PASS and TF_ACC_NETAPP_AD_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_cifs_service_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "tfcifs"
+  comment = "%s"
+  ad_domain = {
+    fqdn = "carchi.local"
+    user = "administrator"
+    password = "%s"
+  }
+  netbios = {
+    aliases = ["tfcifsalias"]
+  }
+  security = {
+    smb_signing = true
+  }
+}`, host, admin, password, svmName, comment, adPassword)
+}
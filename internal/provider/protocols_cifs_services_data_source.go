@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCifsServicesDataSource{}
+
+// NewProtocolsCifsServicesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsServicesDataSource() datasource.DataSource {
+	return &ProtocolsCifsServicesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_services_data_source",
+		},
+	}
+}
+
+// ProtocolsCifsServicesDataSource defines the data source implementation.
+type ProtocolsCifsServicesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsServicesDataSourceModel describes the data source data model.
+type ProtocolsCifsServicesDataSourceModel struct {
+	CxProfileName         types.String                               `tfsdk:"cx_profile_name"`
+	ProtocolsCifsServices []ProtocolsCifsServiceDataSourceModel      `tfsdk:"protocols_cifs_services"`
+	Filter                *ProtocolsCifsServiceDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsCifsServiceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsCifsServiceDataSourceFilterModel struct {
+	SVMName types.String `tfsdk:"svm_name"`
+	Name    types.String `tfsdk:"name"`
+	Domain  types.String `tfsdk:"domain"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCifsServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCifsServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsServices data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "ProtocolsCifsService svm name",
+						Optional:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "NetBIOS name of the CIFS server",
+						Optional:            true,
+					},
+					"domain": schema.StringAttribute{
+						MarkdownDescription: "Fully qualified domain name of the Active Directory domain",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_cifs_services": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: protocolsCifsServiceDataSourceAttributes(false),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCifsServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCifsServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCifsServicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.CifsServicesFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.CifsServicesFilterModel{
+			SVMName: data.Filter.SVMName.ValueString(),
+			Name:    data.Filter.Name.ValueString(),
+			Domain:  data.Filter.Domain.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsServices(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsServices
+		return
+	}
+
+	data.ProtocolsCifsServices = make([]ProtocolsCifsServiceDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsCifsServices[index] = protocolsCifsServiceDataSourceModel(data.CxProfileName, &record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
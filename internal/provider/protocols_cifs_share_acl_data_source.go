@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCifsShareACLDataSource{}
+
+// NewProtocolsCifsShareACLDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsShareACLDataSource() datasource.DataSource {
+	return &ProtocolsCifsShareACLDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_share_acl_data_source",
+		},
+	}
+}
+
+// ProtocolsCifsShareACLDataSource defines the data source implementation.
+type ProtocolsCifsShareACLDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsShareACLDataSourceModel describes the data source data model.
+type ProtocolsCifsShareACLDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	ShareName     types.String `tfsdk:"share_name"`
+	UserOrGroup   types.String `tfsdk:"user_or_group"`
+	Type          types.String `tfsdk:"type"`
+	Permission    types.String `tfsdk:"permission"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCifsShareACLDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCifsShareACLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsShareACL data source",
+
+		Attributes: protocolsCifsShareACLDataSourceAttributes(true),
+	}
+}
+
+// protocolsCifsShareACLDataSourceAttributes returns the attributes of a CIFS share ACL.
+// The connection, share and user or group are required to read a single ACL, and computed in a list of ACLs.
+func protocolsCifsShareACLDataSourceAttributes(single bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cx_profile_name": schema.StringAttribute{
+			MarkdownDescription: "Connection profile name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"svm_name": schema.StringAttribute{
+			MarkdownDescription: "ProtocolsCifsShareACL svm name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"share_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the CIFS share",
+			Required:            single,
+			Computed:            !single,
+		},
+		"user_or_group": schema.StringAttribute{
+			MarkdownDescription: "Windows user or group, or UNIX user or group",
+			Required:            single,
+			Computed:            !single,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of user_or_group, windows, unix_user or unix_group. Defaults to windows",
+			Optional:            single,
+			Computed:            true,
+		},
+		"permission": schema.StringAttribute{
+			MarkdownDescription: "Access right granted to user_or_group",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCifsShareACLDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCifsShareACLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCifsShareACLDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmByName
+		return
+	}
+	if svm == nil {
+		errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", data.SVMName.ValueString()))
+		return
+	}
+	aclType := "windows"
+	if !data.Type.IsNull() {
+		aclType = data.Type.ValueString()
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShareACL(errorHandler, *client, svm.UUID, data.ShareName.ValueString(), data.UserOrGroup.ValueString(), aclType)
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareACL
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS share ACL found", fmt.Sprintf("CIFS share ACL for %s not found on share %s.", data.UserOrGroup.ValueString(), data.ShareName.ValueString()))
+		return
+	}
+	data = protocolsCifsShareACLDataSourceModel(data.CxProfileName, data.SVMName, data.ShareName, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// protocolsCifsShareACLDataSourceModel converts a CIFS share ACL returned by ONTAP.
+// The svm and share names are taken from the configuration, as ONTAP does not always return them.
+func protocolsCifsShareACLDataSourceModel(cxProfileName types.String, svmName types.String, shareName types.String, record *interfaces.ProtocolsCifsShareACLGetDataModelONTAP) ProtocolsCifsShareACLDataSourceModel {
+	return ProtocolsCifsShareACLDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       svmName,
+		ShareName:     shareName,
+		UserOrGroup:   types.StringValue(record.UserOrGroup),
+		Type:          types.StringValue(record.Type),
+		Permission:    types.StringValue(record.Permission),
+	}
+}
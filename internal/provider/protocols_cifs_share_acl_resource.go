@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsCifsShareACLResource{}
+var _ resource.ResourceWithImportState = &ProtocolsCifsShareACLResource{}
+
+// NewProtocolsCifsShareACLResource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsShareACLResource() resource.Resource {
+	return &ProtocolsCifsShareACLResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_share_acl_resource",
+		},
+	}
+}
+
+// ProtocolsCifsShareACLResource defines the resource implementation.
+type ProtocolsCifsShareACLResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsShareACLResourceModel describes the resource data model.
+type ProtocolsCifsShareACLResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	ShareName     types.String   `tfsdk:"share_name"`
+	UserOrGroup   types.String   `tfsdk:"user_or_group"`
+	Type          types.String   `tfsdk:"type"`
+	Permission    types.String   `tfsdk:"permission"`
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsCifsShareACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsCifsShareACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsShareACL resource, the permission of a user or group on a CIFS share",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "ProtocolsCifsShareACL svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"share_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CIFS share",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"user_or_group": schema.StringAttribute{
+				MarkdownDescription: "Windows user or group, eg DOMAIN\\user or Everyone, or UNIX user or group",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of user_or_group, windows, unix_user or unix_group",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("windows"),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf("windows", "unix_user", "unix_group")},
+			},
+			"permission": schema.StringAttribute{
+				MarkdownDescription: "Access right granted to user_or_group, no_access, read, change or full_control",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("no_access", "read", "change", "full_control")},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the svm",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsCifsShareACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsCifsShareACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProtocolsCifsShareACLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// the svm UUID is not known yet after an import
+	if data.ID.IsNull() {
+		svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+		if err != nil {
+			// error reporting done inside GetSvmByName
+			return
+		}
+		if svm == nil {
+			errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", data.SVMName.ValueString()))
+			return
+		}
+		data.ID = types.StringValue(svm.UUID)
+	}
+	if data.Type.IsNull() {
+		data.Type = types.StringValue("windows")
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShareACL(errorHandler, *client, data.ID.ValueString(), data.ShareName.ValueString(), data.UserOrGroup.ValueString(), data.Type.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareACL
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "CIFS share ACL for", fmt.Sprintf("%s on share %s", data.UserOrGroup.ValueString(), data.ShareName.ValueString()))
+		return
+	}
+	setProtocolsCifsShareACLResourceModel(data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsCifsShareACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsCifsShareACLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmByName
+		return
+	}
+	if svm == nil {
+		errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", data.SVMName.ValueString()))
+		return
+	}
+
+	body := interfaces.ProtocolsCifsShareACLResourceBodyDataModelONTAP{
+		UserOrGroup: data.UserOrGroup.ValueString(),
+		Type:        data.Type.ValueString(),
+		Permission:  data.Permission.ValueString(),
+	}
+	err = interfaces.CreateProtocolsCifsShareACL(errorHandler, *client, body, svm.UUID, data.ShareName.ValueString())
+	if err != nil {
+		return
+	}
+	data.ID = types.StringValue(svm.UUID)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsCifsShareACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ProtocolsCifsShareACLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read state file data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// only the permission can be changed, the other attributes require a replacement
+	err = interfaces.UpdateProtocolsCifsShareACL(errorHandler, *client, data.Permission.ValueString(), state.ID.ValueString(), state.ShareName.ValueString(), state.UserOrGroup.ValueString(), state.Type.ValueString())
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsCifsShareACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsCifsShareACLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("ID is null", "svm UUID is null")
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	err = interfaces.DeleteProtocolsCifsShareACL(errorHandler, *client, data.ID.ValueString(), data.ShareName.ValueString(), data.UserOrGroup.ValueString(), data.Type.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+// The ACL that ONTAP creates for Everyone with each share can be imported with svm_name,share_name,Everyone,windows,cx_profile_name.
+func (r *ProtocolsCifsShareACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,share_name,user_or_group,type,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("share_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_or_group"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), idParts[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[4])...)
+}
+
+// setProtocolsCifsShareACLResourceModel updates data with the values returned by ONTAP.
+// Windows names are not case sensitive, the configured value is kept when they only differ by case.
+func setProtocolsCifsShareACLResourceModel(data *ProtocolsCifsShareACLResourceModel, restInfo *interfaces.ProtocolsCifsShareACLGetDataModelONTAP) {
+	data.UserOrGroup = equalFoldStringValue(data.UserOrGroup, restInfo.UserOrGroup)
+	data.Type = types.StringValue(restInfo.Type)
+	data.Permission = types.StringValue(restInfo.Permission)
+}
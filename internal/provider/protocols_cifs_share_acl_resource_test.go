@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCifsShareACLResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccCifsShareACLResourceConfig(`"non-existant"`, "read"),
+				ExpectError: regexp.MustCompile("error creating CIFS share ACL"),
+			},
+			// Create and read
+			{
+				Config: testAccCifsShareACLResourceConfig("netapp-ontap_protocols_cifs_share_resource.example.name", "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_acl_resource.example", "user_or_group", "BUILTIN\\Administrators"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_acl_resource.example", "type", "windows"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_acl_resource.example", "permission", "read"),
+				),
+			},
+			// update and read
+			{
+				Config: testAccCifsShareACLResourceConfig("netapp-ontap_protocols_cifs_share_resource.example.name", "full_control"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_acl_resource.example", "permission", "full_control"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:      "netapp-ontap_protocols_cifs_share_acl_resource.example",
+				ImportState:       true,
+				ImportStateId:     "carchi-test,tfaclshare,BUILTIN\\Administrators,windows,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// shareName is a HCL expression, so that the ACL is created after the share
+func testAccCifsShareACLResourceConfig(shareName, permission string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_cifs_share_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "tfaclshare"
+  path = "/"
+}
+
+resource "netapp-ontap_protocols_cifs_share_acl_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  share_name = %s
+  user_or_group = "BUILTIN\\Administrators"
+  permission = "%s"
+}`, host, admin, password, shareName, permission)
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCifsShareACLsDataSource{}
+
+// NewProtocolsCifsShareACLsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsShareACLsDataSource() datasource.DataSource {
+	return &ProtocolsCifsShareACLsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_share_acls_data_source",
+		},
+	}
+}
+
+// ProtocolsCifsShareACLsDataSource defines the data source implementation.
+type ProtocolsCifsShareACLsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsShareACLsDataSourceModel describes the data source data model.
+type ProtocolsCifsShareACLsDataSourceModel struct {
+	CxProfileName          types.String                                `tfsdk:"cx_profile_name"`
+	SVMName                types.String                                `tfsdk:"svm_name"`
+	ShareName              types.String                                `tfsdk:"share_name"`
+	ProtocolsCifsShareACLs []ProtocolsCifsShareACLDataSourceModel      `tfsdk:"protocols_cifs_share_acls"`
+	Filter                 *ProtocolsCifsShareACLDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsCifsShareACLDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsCifsShareACLDataSourceFilterModel struct {
+	UserOrGroup types.String `tfsdk:"user_or_group"`
+	Type        types.String `tfsdk:"type"`
+	Permission  types.String `tfsdk:"permission"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCifsShareACLsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCifsShareACLsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsShareACLs data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "ProtocolsCifsShareACL svm name",
+				Required:            true,
+			},
+			"share_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CIFS share",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"user_or_group": schema.StringAttribute{
+						MarkdownDescription: "Windows user or group, or UNIX user or group",
+						Optional:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of user_or_group, windows, unix_user or unix_group",
+						Optional:            true,
+					},
+					"permission": schema.StringAttribute{
+						MarkdownDescription: "Access right granted to user_or_group",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_cifs_share_acls": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: protocolsCifsShareACLDataSourceAttributes(false),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCifsShareACLsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCifsShareACLsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCifsShareACLsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmByName
+		return
+	}
+	if svm == nil {
+		errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", data.SVMName.ValueString()))
+		return
+	}
+
+	var filter *interfaces.CifsShareACLsFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.CifsShareACLsFilterModel{
+			UserOrGroup: data.Filter.UserOrGroup.ValueString(),
+			Type:        data.Filter.Type.ValueString(),
+			Permission:  data.Filter.Permission.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShareACLs(errorHandler, *client, svm.UUID, data.ShareName.ValueString(), filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareACLs
+		return
+	}
+
+	data.ProtocolsCifsShareACLs = make([]ProtocolsCifsShareACLDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsCifsShareACLs[index] = protocolsCifsShareACLDataSourceModel(data.CxProfileName, data.SVMName, data.ShareName, &record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
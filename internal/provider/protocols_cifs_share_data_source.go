@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCifsShareDataSource{}
+
+// NewProtocolsCifsShareDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsShareDataSource() datasource.DataSource {
+	return &ProtocolsCifsShareDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_share_data_source",
+		},
+	}
+}
+
+// ProtocolsCifsShareDataSource defines the data source implementation.
+type ProtocolsCifsShareDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsShareDataSourceModel describes the data source data model.
+type ProtocolsCifsShareDataSourceModel struct {
+	CxProfileName          types.String `tfsdk:"cx_profile_name"`
+	SVMName                types.String `tfsdk:"svm_name"`
+	Name                   types.String `tfsdk:"name"`
+	Path                   types.String `tfsdk:"path"`
+	Comment                types.String `tfsdk:"comment"`
+	ContinuouslyAvailable  types.Bool   `tfsdk:"continuously_available"`
+	Oplocks                types.Bool   `tfsdk:"oplocks"`
+	AccessBasedEnumeration types.Bool   `tfsdk:"access_based_enumeration"`
+	Encryption             types.Bool   `tfsdk:"encryption"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCifsShareDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCifsShareDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsShare data source",
+
+		Attributes: protocolsCifsShareDataSourceAttributes(true),
+	}
+}
+
+// protocolsCifsShareDataSourceAttributes returns the attributes of a CIFS share.
+// cx_profile_name, svm_name and name are required to read a single share, and computed in a list of shares.
+func protocolsCifsShareDataSourceAttributes(single bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cx_profile_name": schema.StringAttribute{
+			MarkdownDescription: "Connection profile name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"svm_name": schema.StringAttribute{
+			MarkdownDescription: "ProtocolsCifsShare svm name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the CIFS share",
+			Required:            single,
+			Computed:            !single,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "Path in the namespace of the svm that is shared",
+			Computed:            true,
+		},
+		"comment": schema.StringAttribute{
+			MarkdownDescription: "Text comment about the CIFS share",
+			Computed:            true,
+		},
+		"continuously_available": schema.BoolAttribute{
+			MarkdownDescription: "Whether persistent handles are supported",
+			Computed:            true,
+		},
+		"oplocks": schema.BoolAttribute{
+			MarkdownDescription: "Whether opportunistic locks are enabled",
+			Computed:            true,
+		},
+		"access_based_enumeration": schema.BoolAttribute{
+			MarkdownDescription: "Whether files and folders are only listed to users with access to them",
+			Computed:            true,
+		},
+		"encryption": schema.BoolAttribute{
+			MarkdownDescription: "Whether SMB encryption is required to access the share",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCifsShareDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCifsShareDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCifsShareDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShareByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS share found", fmt.Sprintf("CIFS share %s not found in svm %s.", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+	data = protocolsCifsShareDataSourceModel(data.CxProfileName, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// protocolsCifsShareDataSourceModel converts a CIFS share returned by ONTAP
+func protocolsCifsShareDataSourceModel(cxProfileName types.String, record *interfaces.ProtocolsCifsShareGetDataModelONTAP) ProtocolsCifsShareDataSourceModel {
+	return ProtocolsCifsShareDataSourceModel{
+		CxProfileName:          cxProfileName,
+		SVMName:                types.StringValue(record.SVM.Name),
+		Name:                   types.StringValue(record.Name),
+		Path:                   types.StringValue(record.Path),
+		Comment:                types.StringValue(record.Comment),
+		ContinuouslyAvailable:  types.BoolValue(record.ContinuouslyAvailable),
+		Oplocks:                types.BoolValue(record.Oplocks),
+		AccessBasedEnumeration: types.BoolValue(record.AccessBasedEnumeration),
+		Encryption:             types.BoolValue(record.Encryption),
+	}
+}
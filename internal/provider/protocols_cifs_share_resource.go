@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsCifsShareResource{}
+var _ resource.ResourceWithImportState = &ProtocolsCifsShareResource{}
+
+// NewProtocolsCifsShareResource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsShareResource() resource.Resource {
+	return &ProtocolsCifsShareResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_share_resource",
+		},
+	}
+}
+
+// ProtocolsCifsShareResource defines the resource implementation.
+type ProtocolsCifsShareResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsShareResourceModel describes the resource data model.
+type ProtocolsCifsShareResourceModel struct {
+	CxProfileName          types.String   `tfsdk:"cx_profile_name"`
+	SVMName                types.String   `tfsdk:"svm_name"`
+	Name                   types.String   `tfsdk:"name"`
+	Path                   types.String   `tfsdk:"path"`
+	Comment                types.String   `tfsdk:"comment"`
+	ContinuouslyAvailable  types.Bool     `tfsdk:"continuously_available"`
+	Oplocks                types.Bool     `tfsdk:"oplocks"`
+	AccessBasedEnumeration types.Bool     `tfsdk:"access_based_enumeration"`
+	Encryption             types.Bool     `tfsdk:"encryption"`
+	ID                     types.String   `tfsdk:"id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsCifsShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsCifsShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		}
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsShare resource. ONTAP grants full_control to Everyone on a new share, use netapp-ontap_protocols_cifs_share_acl_resource to manage the ACLs",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "ProtocolsCifsShare svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CIFS share",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path in the namespace of the svm that is shared, eg /vol1",
+				Required:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Text comment about the CIFS share",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"continuously_available":   computedBool("Whether persistent handles are supported, for Hyper-V and SQL Server over SMB 3"),
+			"oplocks":                  computedBool("Whether opportunistic locks are enabled, to let clients cache data"),
+			"access_based_enumeration": computedBool("Whether files and folders are only listed to users with access to them"),
+			"encryption":               computedBool("Whether SMB encryption is required to access the share"),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the svm",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsCifsShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsCifsShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProtocolsCifsShareResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShareByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareByName
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "CIFS share", data.Name.ValueString())
+		return
+	}
+	setProtocolsCifsShareResourceModel(data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsCifsShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsCifsShareResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := protocolsCifsShareBody(data)
+	body.Name = data.Name.ValueString()
+	body.SVM = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	err = interfaces.CreateProtocolsCifsShare(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the values set by ONTAP, eg oplocks
+	restInfo, err := interfaces.GetProtocolsCifsShareByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS share found", fmt.Sprintf("CIFS share %s not found after create.", data.Name.ValueString()))
+		return
+	}
+	setProtocolsCifsShareResourceModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsCifsShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ProtocolsCifsShareResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read state file data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.UpdateProtocolsCifsShare(errorHandler, *client, protocolsCifsShareBody(data), state.ID.ValueString(), state.Name.ValueString())
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShareByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShareByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No CIFS share found", fmt.Sprintf("CIFS share %s not found after update.", data.Name.ValueString()))
+		return
+	}
+	setProtocolsCifsShareResourceModel(data, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsCifsShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsCifsShareResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("ID is null", "svm UUID is null")
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	err = interfaces.DeleteProtocolsCifsShare(errorHandler, *client, data.ID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsCifsShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// protocolsCifsShareBody returns the settings shared by POST and PATCH.  Unknown values are left for ONTAP to set.
+func protocolsCifsShareBody(data *ProtocolsCifsShareResourceModel) interfaces.ProtocolsCifsShareResourceBodyDataModelONTAP {
+	body := interfaces.ProtocolsCifsShareResourceBodyDataModelONTAP{
+		Path:                   data.Path.ValueString(),
+		ContinuouslyAvailable:  knownBool(data.ContinuouslyAvailable),
+		Oplocks:                knownBool(data.Oplocks),
+		AccessBasedEnumeration: knownBool(data.AccessBasedEnumeration),
+		Encryption:             knownBool(data.Encryption),
+	}
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	return body
+}
+
+// setProtocolsCifsShareResourceModel updates data with the values returned by ONTAP.
+// Share names are not case sensitive, the configured value is kept when they only differ by case.
+func setProtocolsCifsShareResourceModel(data *ProtocolsCifsShareResourceModel, restInfo *interfaces.ProtocolsCifsShareGetDataModelONTAP) {
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Name = equalFoldStringValue(data.Name, restInfo.Name)
+	data.Path = types.StringValue(restInfo.Path)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.ContinuouslyAvailable = types.BoolValue(restInfo.ContinuouslyAvailable)
+	data.Oplocks = types.BoolValue(restInfo.Oplocks)
+	data.AccessBasedEnumeration = types.BoolValue(restInfo.AccessBasedEnumeration)
+	data.Encryption = types.BoolValue(restInfo.Encryption)
+}
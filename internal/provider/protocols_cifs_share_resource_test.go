@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCifsShareResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccCifsShareResourceConfig("non-existant", "/", "created by terraform"),
+				ExpectError: regexp.MustCompile("error creating CIFS share"),
+			},
+			// Create and read
+			{
+				Config: testAccCifsShareResourceConfig("carchi-test", "/", "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_resource.example", "name", "tfshare"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_resource.example", "path", "/"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_resource.example", "comment", "created by terraform"),
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_resource.example", "access_based_enumeration", "true"),
+				),
+			},
+			// update and read
+			{
+				Config: testAccCifsShareResourceConfig("carchi-test", "/", "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_cifs_share_resource.example", "comment", "updated by terraform"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:      "netapp-ontap_protocols_cifs_share_resource.example",
+				ImportState:       true,
+				ImportStateId:     "carchi-test,tfshare,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCifsShareResourceConfig(svmName, sharePath, comment string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_cifs_share_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "tfshare"
+  path = "%s"
+  comment = "%s"
+  access_based_enumeration = true
+}`, host, admin, password, svmName, sharePath, comment)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCifsSharesDataSource{}
+
+// NewProtocolsCifsSharesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCifsSharesDataSource() datasource.DataSource {
+	return &ProtocolsCifsSharesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_cifs_shares_data_source",
+		},
+	}
+}
+
+// ProtocolsCifsSharesDataSource defines the data source implementation.
+type ProtocolsCifsSharesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsCifsSharesDataSourceModel describes the data source data model.
+type ProtocolsCifsSharesDataSourceModel struct {
+	CxProfileName       types.String                             `tfsdk:"cx_profile_name"`
+	ProtocolsCifsShares []ProtocolsCifsShareDataSourceModel      `tfsdk:"protocols_cifs_shares"`
+	Filter              *ProtocolsCifsShareDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsCifsShareDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsCifsShareDataSourceFilterModel struct {
+	SVMName types.String `tfsdk:"svm_name"`
+	Name    types.String `tfsdk:"name"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCifsSharesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCifsSharesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCifsShares data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "ProtocolsCifsShare svm name",
+						Optional:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the CIFS share, wildcards are supported",
+						Optional:            true,
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path in the namespace of the svm that is shared",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_cifs_shares": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: protocolsCifsShareDataSourceAttributes(false),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCifsSharesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCifsSharesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCifsSharesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.CifsSharesFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.CifsSharesFilterModel{
+			SVMName: data.Filter.SVMName.ValueString(),
+			Name:    data.Filter.Name.ValueString(),
+			Path:    data.Filter.Path.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetProtocolsCifsShares(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsCifsShares
+		return
+	}
+
+	data.ProtocolsCifsShares = make([]ProtocolsCifsShareDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsCifsShares[index] = protocolsCifsShareDataSourceModel(data.CxProfileName, &record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
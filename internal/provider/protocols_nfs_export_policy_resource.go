@@ -157,10 +157,14 @@ func (r *ExportPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	_, err = interfaces.GetExportPolicy(errorHandler, *client, data.ID.ValueString())
+	exportPolicy, err := interfaces.GetExportPolicy(errorHandler, *client, data.ID.ValueString())
 	if err != nil {
 		return
 	}
+	if exportPolicy == nil {
+		removeResourceNotFound(ctx, resp, "export policy", data.Name.ValueString())
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
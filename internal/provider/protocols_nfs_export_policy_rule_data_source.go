@@ -166,7 +166,7 @@ func (d *ExportPolicyRuleDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -196,6 +196,10 @@ func (d *ExportPolicyRuleDataSource) Read(ctx context.Context, req datasource.Re
 		if err != nil {
 			return
 		}
+		if exportPolicy == nil {
+			errorHandler.MakeAndReportError("No export policy found", fmt.Sprintf("export policy %s not found.", data.ExportPolicyName.ValueString()))
+			return
+		}
 		exportPolicyID = strconv.Itoa(exportPolicy.ID)
 	} else {
 		exportPolicyID = data.ExportPolicyID.ValueString()
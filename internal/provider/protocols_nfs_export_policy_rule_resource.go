@@ -353,17 +353,21 @@ func (r *ExportPolicyRuleResource) Read(ctx context.Context, req resource.ReadRe
 		if err != nil {
 			return
 		}
+		if exportPolicy == nil {
+			removeResourceNotFound(ctx, resp, "export policy", data.ExportPolicyName.ValueString())
+			return
+		}
 		exportPolicyID = strconv.Itoa(exportPolicy.ID)
 	} else {
 		exportPolicyID = data.ExportPolicyID.ValueString()
 	}
 
 	restInfo, err := interfaces.GetExportPolicyRule(errorHandler, *client, exportPolicyID, data.Index.ValueInt64())
-	if restInfo == nil {
-		errorHandler.MakeAndReportError("No export policy rule found", fmt.Sprintf("export policy rule %s not found.", data.Index.String()))
+	if err != nil {
 		return
 	}
-	if err != nil {
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "export policy rule", data.Index.String())
 		return
 	}
 	var roRule, rwRule, protocols, superuser, clientsMatch []types.String
@@ -438,6 +442,10 @@ func (r *ExportPolicyRuleResource) Update(ctx context.Context, req resource.Upda
 		if err != nil {
 			return
 		}
+		if exportPolicy == nil {
+			errorHandler.MakeAndReportError("No export policy found", fmt.Sprintf("export policy %s not found.", data.ExportPolicyName.ValueString()))
+			return
+		}
 		exportPolicyID = strconv.Itoa(exportPolicy.ID)
 	} else {
 		exportPolicyID = data.ExportPolicyID.ValueString()
@@ -523,6 +531,10 @@ func (r *ExportPolicyRuleResource) Delete(ctx context.Context, req resource.Dele
 		if err != nil {
 			return
 		}
+		if exportPolicy == nil {
+			errorHandler.MakeAndReportError("No export policy found", fmt.Sprintf("export policy %s not found.", data.ExportPolicyName.ValueString()))
+			return
+		}
 		exportPolicyID = strconv.Itoa(exportPolicy.ID)
 	} else {
 		exportPolicyID = data.ExportPolicyID.ValueString()
@@ -536,6 +548,36 @@ func (r *ExportPolicyRuleResource) Delete(ctx context.Context, req resource.Dele
 }
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
+// The ID is svm_name,export_policy_name,index,cx_profile_name.
 func (r *ExportPolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts, ok := splitImportID(req.ID, "svm_name,export_policy_name,index,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	index, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an integer for index. Got: %q", idParts[2]))
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, types.StringValue(idParts[3]))
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	filter := map[string]string{"name": idParts[1], "svm.name": idParts[0]}
+	exportPolicy, err := interfaces.GetNfsExportPolicyByName(errorHandler, *client, &filter)
+	if err != nil {
+		return
+	}
+	if exportPolicy == nil {
+		errorHandler.MakeAndReportError("No export policy found", fmt.Sprintf("export policy %s not found in svm %s.", idParts[1], idParts[0]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s_%s_%s_%d", idParts[3], idParts[0], idParts[1], index))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("export_policy_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("export_policy_id"), strconv.Itoa(exportPolicy.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
 }
@@ -10,6 +10,7 @@ import (
 )
 
 func TestAccNFSExportPolicyRuleResource(t *testing.T) {
+	testAccReplay(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -189,7 +189,7 @@ func (d *ProtocolsNFSExportPolicyRulesDataSource) Read(ctx context.Context, req
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -215,6 +215,10 @@ func (d *ProtocolsNFSExportPolicyRulesDataSource) Read(ctx context.Context, req
 	if err != nil {
 		return
 	}
+	if exportPolicy == nil {
+		errorHandler.MakeAndReportError("No export policy found", fmt.Sprintf("export policy %s not found.", data.ExportPolicyName.ValueString()))
+		return
+	}
 	exportPolicyID = strconv.Itoa(exportPolicy.ID)
 
 	restInfo, err := interfaces.GetListExportPolicyRules(errorHandler, *client, exportPolicyID, filter, cluster.Version)
@@ -310,7 +310,7 @@ func (d *ProtocolsNfsServiceDataSource) Read(ctx context.Context, req datasource
 		// error reporting done inside NewClient
 		return
 	}
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
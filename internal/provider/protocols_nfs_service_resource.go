@@ -35,6 +35,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ProtocolsNfsServiceResource{}
 var _ resource.ResourceWithImportState = &ProtocolsNfsServiceResource{}
+var _ resource.ResourceWithModifyPlan = &ProtocolsNfsServiceResource{}
 
 // NewProtocolsNfsServiceResource is a helper function to simplify the provider implementation.
 func NewProtocolsNfsServiceResource() resource.Resource {
@@ -460,6 +461,33 @@ func (r *ProtocolsNfsServiceResource) Configure(ctx context.Context, req resourc
 	r.config.providerConfig = config
 }
 
+// ModifyPlan reports attributes that are not supported by the ONTAP version of the cluster, before any change is made.
+// The configuration is used rather than the plan, as these attributes have default values.
+func (r *ProtocolsNfsServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config *ProtocolsNfsServiceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	// config is null when the resource is destroyed
+	if config == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	requirements := map[string]string{}
+	if config.Root != nil {
+		requirements["attribute root"] = "9.11.0"
+	}
+	if config.Security != nil {
+		requirements["attribute security"] = "9.11.0"
+	}
+	if config.Windows != nil {
+		requirements["attribute windows"] = "9.11.0"
+	}
+	if config.Transport != nil && !config.Transport.TCPMaxXferSize.IsNull() {
+		requirements["attribute transport.tcp_max_transfer_size"] = "9.11.0"
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkONTAPVersion(errorHandler, r.config, config.CxProfileName, requirements)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *ProtocolsNfsServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data ProtocolsNfsServiceResourceModel
@@ -478,7 +506,7 @@ func (r *ProtocolsNfsServiceResource) Read(ctx context.Context, req resource.Rea
 		// error reporting done inside NewClient
 		return
 	}
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -494,7 +522,7 @@ func (r *ProtocolsNfsServiceResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No NFS service found", fmt.Sprintf("NFS service not found."))
+		removeResourceNotFound(ctx, resp, "NFS service for svm", data.SVMName.ValueString())
 		return
 	}
 
@@ -568,7 +596,7 @@ func (r *ProtocolsNfsServiceResource) Create(ctx context.Context, req resource.C
 		// error reporting done inside NewClient
 		return
 	}
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if cluster == nil {
 		errorHandler.MakeAndReportError("No cluster found", fmt.Sprintf("Cluster not found."))
 		return
@@ -747,7 +775,7 @@ func (r *ProtocolsNfsServiceResource) Update(ctx context.Context, req resource.U
 		errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", data.SVMName.ValueString()))
 		return
 	}
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
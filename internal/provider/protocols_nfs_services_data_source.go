@@ -265,7 +265,7 @@ func (d *ProtocolsNfsServicesDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
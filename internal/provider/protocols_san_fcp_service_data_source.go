@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanFcpServiceDataSource{}
+
+// NewProtocolsSanFcpServiceDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanFcpServiceDataSource() datasource.DataSource {
+	return &ProtocolsSanFcpServiceDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_fcp_service_data_source",
+		},
+	}
+}
+
+// ProtocolsSanFcpServiceDataSource defines the data source implementation.
+type ProtocolsSanFcpServiceDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanFcpServiceDataSourceModel describes the data source data model.
+type ProtocolsSanFcpServiceDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	TargetName    types.String `tfsdk:"target_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanFcpServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanFcpServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanFcpService data source",
+
+		Attributes: protocolsSanFcpServiceDataSourceAttributes(true),
+	}
+}
+
+// protocolsSanFcpServiceDataSourceAttributes returns the attributes of an FCP service.
+// cx_profile_name and svm_name are required to read a single service, and computed in a list of services.
+func protocolsSanFcpServiceDataSourceAttributes(single bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cx_profile_name": schema.StringAttribute{
+			MarkdownDescription: "Connection profile name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"svm_name": schema.StringAttribute{
+			MarkdownDescription: "ProtocolsSanFcpService svm name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the FCP service is enabled",
+			Computed:            true,
+		},
+		"target_name": schema.StringAttribute{
+			MarkdownDescription: "World wide node name (WWNN) of the FC target",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanFcpServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanFcpServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanFcpServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanFcpService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanFcpService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No FCP service found", fmt.Sprintf("FCP service for svm %s not found.", data.SVMName.ValueString()))
+		return
+	}
+	data = protocolsSanFcpServiceDataSourceModel(data.CxProfileName, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// protocolsSanFcpServiceDataSourceModel converts an FCP service returned by ONTAP
+func protocolsSanFcpServiceDataSourceModel(cxProfileName types.String, record *interfaces.ProtocolsSanFcpServiceGetDataModelONTAP) ProtocolsSanFcpServiceDataSourceModel {
+	return ProtocolsSanFcpServiceDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       types.StringValue(record.SVM.Name),
+		Enabled:       types.BoolValue(record.Enabled),
+		TargetName:    types.StringValue(record.Target.Name),
+	}
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsSanFcpServiceResource{}
+var _ resource.ResourceWithImportState = &ProtocolsSanFcpServiceResource{}
+
+// NewProtocolsSanFcpServiceResource is a helper function to simplify the provider implementation.
+func NewProtocolsSanFcpServiceResource() resource.Resource {
+	return &ProtocolsSanFcpServiceResource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_fcp_service_resource",
+		},
+	}
+}
+
+// ProtocolsSanFcpServiceResource defines the resource implementation.
+type ProtocolsSanFcpServiceResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanFcpServiceResourceModel describes the resource data model.
+type ProtocolsSanFcpServiceResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	TargetName    types.String   `tfsdk:"target_name"`
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsSanFcpServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsSanFcpServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanFcpService resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "ProtocolsSanFcpService svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the FCP service is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"target_name": schema.StringAttribute{
+				MarkdownDescription: "World wide node name (WWNN) of the FC target, set by ONTAP",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the svm",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsSanFcpServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsSanFcpServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProtocolsSanFcpServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanFcpService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanFcpService
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "FCP service for svm", data.SVMName.ValueString())
+		return
+	}
+	setProtocolsSanFcpServiceResourceModel(data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsSanFcpServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsSanFcpServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := protocolsSanFcpServiceBody(data)
+	body.SVM = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	err = interfaces.CreateProtocolsSanFcpService(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the target name set by ONTAP
+	restInfo, err := interfaces.GetProtocolsSanFcpService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanFcpService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No FCP service found", fmt.Sprintf("FCP service for svm %s not found after create.", data.SVMName.ValueString()))
+		return
+	}
+	setProtocolsSanFcpServiceResourceModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsSanFcpServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *ProtocolsSanFcpServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read state file data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.UpdateProtocolsSanFcpService(errorHandler, *client, protocolsSanFcpServiceBody(data), state.ID.ValueString())
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanFcpService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanFcpService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No FCP service found", fmt.Sprintf("FCP service for svm %s not found after update.", data.SVMName.ValueString()))
+		return
+	}
+	setProtocolsSanFcpServiceResourceModel(data, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The service is disabled before it is deleted.
+func (r *ProtocolsSanFcpServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsSanFcpServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("ID is null", "svm UUID is null")
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	err = interfaces.DeleteProtocolsSanFcpService(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsSanFcpServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
+
+// protocolsSanFcpServiceBody returns the settings shared by POST and PATCH.
+func protocolsSanFcpServiceBody(data *ProtocolsSanFcpServiceResourceModel) interfaces.ProtocolsSanFcpServiceResourceBodyDataModelONTAP {
+	return interfaces.ProtocolsSanFcpServiceResourceBodyDataModelONTAP{
+		Enabled: knownBool(data.Enabled),
+	}
+}
+
+// setProtocolsSanFcpServiceResourceModel updates data with the values returned by ONTAP
+func setProtocolsSanFcpServiceResourceModel(data *ProtocolsSanFcpServiceResourceModel, restInfo *interfaces.ProtocolsSanFcpServiceGetDataModelONTAP) {
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.TargetName = types.StringValue(restInfo.Target.Name)
+}
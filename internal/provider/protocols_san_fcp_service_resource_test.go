@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSanFcpServiceResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccSanFcpServiceResourceConfig("non-existant", true),
+				ExpectError: regexp.MustCompile("error creating FCP service"),
+			},
+			// Create and read
+			{
+				Config: testAccSanFcpServiceResourceConfig("carchi-test", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_fcp_service_resource.example", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_protocols_san_fcp_service_resource.example", "target_name"),
+				),
+			},
+			// disable in place
+			{
+				Config: testAccSanFcpServiceResourceConfig("carchi-test", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_protocols_san_fcp_service_resource.example", "enabled", "false"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:      "netapp-ontap_protocols_san_fcp_service_resource.example",
+				ImportState:       true,
+				ImportStateId:     "carchi-test,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSanFcpServiceResourceConfig(svmName string, enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_protocols_san_fcp_service_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  enabled = %t
+}`, host, admin, password, svmName, enabled)
+}
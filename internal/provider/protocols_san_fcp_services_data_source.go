@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanFcpServicesDataSource{}
+
+// NewProtocolsSanFcpServicesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanFcpServicesDataSource() datasource.DataSource {
+	return &ProtocolsSanFcpServicesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_fcp_services_data_source",
+		},
+	}
+}
+
+// ProtocolsSanFcpServicesDataSource defines the data source implementation.
+type ProtocolsSanFcpServicesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanFcpServicesDataSourceModel describes the data source data model.
+type ProtocolsSanFcpServicesDataSourceModel struct {
+	CxProfileName           types.String                                 `tfsdk:"cx_profile_name"`
+	ProtocolsSanFcpServices []ProtocolsSanFcpServiceDataSourceModel      `tfsdk:"protocols_san_fcp_services"`
+	Filter                  *ProtocolsSanFcpServiceDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsSanFcpServiceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanFcpServiceDataSourceFilterModel struct {
+	SVMName types.String `tfsdk:"svm_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanFcpServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanFcpServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanFcpServices data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "ProtocolsSanFcpService svm name, wildcards are supported",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_san_fcp_services": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: protocolsSanFcpServiceDataSourceAttributes(false),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanFcpServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanFcpServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanFcpServicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.SanFcpServicesFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.SanFcpServicesFilterModel{
+			SVMName: data.Filter.SVMName.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanFcpServices(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsSanFcpServices
+		return
+	}
+
+	data.ProtocolsSanFcpServices = make([]ProtocolsSanFcpServiceDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsSanFcpServices[index] = protocolsSanFcpServiceDataSourceModel(data.CxProfileName, &record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanIscsiServicesDataSource{}
+
+// NewProtocolsSanIscsiServicesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanIscsiServicesDataSource() datasource.DataSource {
+	return &ProtocolsSanIscsiServicesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "protocols_san_iscsi_services_data_source",
+		},
+	}
+}
+
+// ProtocolsSanIscsiServicesDataSource defines the data source implementation.
+type ProtocolsSanIscsiServicesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// ProtocolsSanIscsiServicesDataSourceModel describes the data source data model.
+type ProtocolsSanIscsiServicesDataSourceModel struct {
+	CxProfileName             types.String                                   `tfsdk:"cx_profile_name"`
+	ProtocolsSanIscsiServices []ProtocolsSanIscsiServiceDataSourceModel      `tfsdk:"protocols_san_iscsi_services"`
+	Filter                    *ProtocolsSanIscsiServiceDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsSanIscsiServiceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanIscsiServiceDataSourceFilterModel struct {
+	SVMName types.String `tfsdk:"svm_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanIscsiServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanIscsiServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanIscsiServices data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "ProtocolsSanIscsiService svm name, wildcards are supported",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_san_iscsi_services": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: protocolsSanIscsiServiceDataSourceAttributes(false),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanIscsiServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanIscsiServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanIscsiServicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.SanIscsiServicesFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.SanIscsiServicesFilterModel{
+			SVMName: data.Filter.SVMName.ValueString(),
+		}
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanIscsiServices(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsSanIscsiServices
+		return
+	}
+
+	data.ProtocolsSanIscsiServices = make([]ProtocolsSanIscsiServiceDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsSanIscsiServices[index] = protocolsSanIscsiServiceDataSourceModel(data.CxProfileName, &record)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
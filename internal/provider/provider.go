@@ -3,13 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
 )
 
 // Ensure ONTAPProvider satisfies various provider interfaces.
@@ -23,22 +32,58 @@ type ONTAPProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// auditLog is opened by the first Configure, and reused while audit_log_file does not change
+	auditLog     *restclient.AuditLog
+	auditLogFile string
 }
 
+// registerSensitiveFields ensures the sensitive attribute names are only registered once, as the schemas do not change
+var registerSensitiveFields sync.Once
+
 // ConnectionProfileModel associate a connection profile with a name
 // TODO: augment address with hostname, ...
 type ConnectionProfileModel struct {
-	Name          types.String `tfsdk:"name"`
-	Hostname      types.String `tfsdk:"hostname"`
-	Username      types.String `tfsdk:"username"`
-	Password      types.String `tfsdk:"password"`
-	ValidateCerts types.Bool   `tfsdk:"validate_certs"`
+	Name                   types.String `tfsdk:"name"`
+	Hostname               types.String `tfsdk:"hostname"`
+	Port                   types.Int64  `tfsdk:"port"`
+	Scheme                 types.String `tfsdk:"scheme"`
+	APIRoot                types.String `tfsdk:"api_root"`
+	ProxyURL               types.String `tfsdk:"proxy_url"`
+	Username               types.String `tfsdk:"username"`
+	Password               types.String `tfsdk:"password"`
+	ValidateCerts          types.Bool   `tfsdk:"validate_certs"`
+	MaxConcurrentRequests  types.Int64  `tfsdk:"max_concurrent_requests"`
+	PageSize               types.Int64  `tfsdk:"page_size"`
+	RecordLimit            types.Int64  `tfsdk:"record_limit"`
+	Retry                  *RetryModel  `tfsdk:"retry"`
+	ClientCertificate      types.String `tfsdk:"client_certificate"`
+	ClientKey              types.String `tfsdk:"client_key"`
+	CACertificate          types.String `tfsdk:"ca_certificate"`
+	TLSServerName          types.String `tfsdk:"tls_server_name"`
+	CertificateFingerprint types.String `tfsdk:"certificate_fingerprint"`
+	MinTLSVersion          types.String `tfsdk:"min_tls_version"`
+	ConnectTimeout         types.Int64  `tfsdk:"connect_timeout"`
+	TLSHandshakeTimeout    types.Int64  `tfsdk:"tls_handshake_timeout"`
+	ResponseTimeout        types.Int64  `tfsdk:"response_timeout"`
+	ReturnTimeout          types.Int64  `tfsdk:"return_timeout"`
+}
+
+// RetryModel describes how to retry transient failures
+type RetryModel struct {
+	MaxAttempts          types.Int64    `tfsdk:"max_attempts"`
+	InitialBackoff       types.Int64    `tfsdk:"initial_backoff"`
+	MaxBackoff           types.Int64    `tfsdk:"max_backoff"`
+	RetryableStatusCodes []types.Int64  `tfsdk:"retryable_status_codes"`
+	RetryableErrorCodes  []types.String `tfsdk:"retryable_error_codes"`
+	RetryNonIdempotent   types.Bool     `tfsdk:"retry_non_idempotent"`
 }
 
 // ONTAPProviderModel describes the provider data model.
 type ONTAPProviderModel struct {
 	Endpoint             types.String             `tfsdk:"endpoint"`
 	JobCompletionTimeOut types.Int64              `tfsdk:"job_completion_timeout"`
+	CredentialsFile      types.String             `tfsdk:"credentials_file"`
+	AuditLogFile         types.String             `tfsdk:"audit_log_file"`
 	ConnectionProfiles   []ConnectionProfileModel `tfsdk:"connection_profiles"`
 }
 
@@ -61,9 +106,17 @@ func (p *ONTAPProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "Time in seconds to wait for completion. Default to 600 seconds",
 				Optional:            true,
 			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "YAML or JSON file defining connection_profiles, defaults to NETAPP_ONTAP_CREDENTIALS_FILE. Profiles in connection_profiles take precedence",
+				Optional:            true,
+			},
+			"audit_log_file": schema.StringAttribute{
+				MarkdownDescription: "File recording each POST, PATCH and DELETE call as a JSON line, with the resource, method, path, status, job UUID and duration. Sensitive fields are redacted from the query and the body. Entries are appended, and the file is created if needed",
+				Optional:            true,
+			},
 			"connection_profiles": schema.ListNestedAttribute{
-				MarkdownDescription: "Define connection and credentials",
-				Required:            true,
+				MarkdownDescription: "Define connection and credentials. A default profile can also be defined with NETAPP_ONTAP_HOSTNAME, NETAPP_ONTAP_USERNAME, NETAPP_ONTAP_PASSWORD and NETAPP_ONTAP_VALIDATE_CERTS",
+				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -74,19 +127,136 @@ func (p *ONTAPProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 							MarkdownDescription: "ONTAP management interface IP address or name",
 							Required:            true,
 						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "TCP port of the ONTAP management interface, or of the reverse proxy, defaults to 443 for https and 80 for http",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"scheme": schema.StringAttribute{
+							MarkdownDescription: "https or http, defaults to https. http is only meant for a local test stand-in, as credentials are sent in clear text",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("https", "http"),
+							},
+						},
+						"api_root": schema.StringAttribute{
+							MarkdownDescription: "Path of the REST API, defaults to api. Use a sub-path when ONTAP is reached through a reverse proxy, eg ontap1/api",
+							Optional:            true,
+						},
+						"proxy_url": schema.StringAttribute{
+							MarkdownDescription: "URL of a forward proxy, eg http://proxy.example.com:3128, with optional user and password. Defaults to HTTPS_PROXY and NO_PROXY",
+							Optional:            true,
+							Sensitive:           true,
+						},
 						"username": schema.StringAttribute{
-							MarkdownDescription: "ONTAP management user name (cluster or svm)",
-							Required:            true,
+							MarkdownDescription: "ONTAP management user name (cluster or svm), required with password",
+							Optional:            true,
 						},
 						"password": schema.StringAttribute{
-							MarkdownDescription: "ONTAP management password for username",
-							Required:            true,
+							MarkdownDescription: "ONTAP management password for username, required unless client_certificate and client_key are set",
+							Optional:            true,
 							Sensitive:           true,
 						},
+						"client_certificate": schema.StringAttribute{
+							MarkdownDescription: "Client certificate for certificate authentication, as PEM content or file name. The ONTAP account must use the cert authentication method",
+							Optional:            true,
+						},
+						"client_key": schema.StringAttribute{
+							MarkdownDescription: "Private key for client_certificate, as PEM content or file name",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"ca_certificate": schema.StringAttribute{
+							MarkdownDescription: "Bundle of CA certificates to validate the ONTAP certificate, as PEM content or file name. Defaults to the system store",
+							Optional:            true,
+						},
+						"tls_server_name": schema.StringAttribute{
+							MarkdownDescription: "Name to validate the ONTAP certificate against, when it does not match hostname",
+							Optional:            true,
+						},
+						"certificate_fingerprint": schema.StringAttribute{
+							MarkdownDescription: "SHA-256 fingerprint of the ONTAP certificate, with or without colons. When set, only this certificate is accepted, even with validate_certs set to false",
+							Optional:            true,
+						},
+						"min_tls_version": schema.StringAttribute{
+							MarkdownDescription: "Minimum TLS version, 1.2 or 1.3, defaults to 1.2",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("1.2", "1.3"),
+							},
+						},
+						"connect_timeout": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds to wait for a TCP connection to the ONTAP management interface, defaults to 30",
+							Optional:            true,
+						},
+						"tls_handshake_timeout": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds to wait for the TLS handshake, defaults to 10",
+							Optional:            true,
+						},
+						"response_timeout": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds to wait for the response headers once a REST call is sent, must be greater than return_timeout, defaults to return_timeout plus 60",
+							Optional:            true,
+						},
+						"return_timeout": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds ONTAP waits for a job to complete before returning a POST, PATCH or DELETE response, between 1 and 120, defaults to 60. Longer jobs are polled until job_completion_timeout",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 120),
+							},
+						},
 						"validate_certs": schema.BoolAttribute{
 							MarkdownDescription: "Whether to enforce SSL certificate validation, defaults to true",
 							Optional:            true,
 						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of concurrent REST requests sent to the cluster, shared by all resources and data sources using this profile, defaults to 6",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"page_size": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of records to retrieve per REST call (max_records), defaults to the ONTAP default. Next pages are retrieved automatically",
+							Optional:            true,
+						},
+						"record_limit": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of records to retrieve across all pages for a single data source, defaults to no limit",
+							Optional:            true,
+						},
+						"retry": schema.SingleNestedAttribute{
+							MarkdownDescription: "Retry policy for transient failures, eg during a node takeover or a LIF migration",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"max_attempts": schema.Int64Attribute{
+									MarkdownDescription: "Total number of attempts, including the first one, defaults to 3. 1 disables retries",
+									Optional:            true,
+								},
+								"initial_backoff": schema.Int64Attribute{
+									MarkdownDescription: "Time in seconds to wait before the first retry, defaults to 1. It doubles on each retry, with a random jitter",
+									Optional:            true,
+								},
+								"max_backoff": schema.Int64Attribute{
+									MarkdownDescription: "Maximum time in seconds to wait between two attempts, defaults to 30",
+									Optional:            true,
+								},
+								"retryable_status_codes": schema.ListAttribute{
+									ElementType:         types.Int64Type,
+									MarkdownDescription: "HTTP status codes to retry, defaults to 502, 503, 504",
+									Optional:            true,
+								},
+								"retryable_error_codes": schema.ListAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "ONTAP REST error codes to retry, defaults to 13303808, 13303812 and 13303822 (SnapMirror transfer or modification in progress) and 460770 (another job is already running on the object). The list replaces the defaults",
+									Optional:            true,
+								},
+								"retry_non_idempotent": schema.BoolAttribute{
+									MarkdownDescription: "Whether to retry POST and PATCH requests, defaults to false",
+									Optional:            true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -103,13 +273,32 @@ func (p *ONTAPProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		tflog.Error(ctx, fmt.Sprintf("unable to read data from req: %#v", req))
 		return
 	}
-	// Required attributes
-	// For optional values we can use data.Endpoint.IsNull(), ...
-	if len(data.ConnectionProfiles) == 0 {
-		resp.Diagnostics.AddError("no connection profile", "At least one connection profile must be defined.")
+	// Profiles are read from the environment, then from the credentials file, then from connection_profiles.
+	// A profile with the same name replaces the previous one, so that HCL takes precedence.
+	connectionProfiles := make(map[string]ConnectionProfile, len(data.ConnectionProfiles))
+	envProfile, err := connectionProfileFromEnv()
+	if err != nil {
+		resp.Diagnostics.AddError("invalid environment variable", err.Error())
 		return
 	}
-	connectionProfiles := make(map[string]ConnectionProfile, len(data.ConnectionProfiles))
+	if envProfile != nil {
+		connectionProfiles[envProfileName] = *envProfile
+	}
+	credentialsFile := data.CredentialsFile.ValueString()
+	if data.CredentialsFile.IsNull() {
+		credentialsFile = os.Getenv(envCredentialsFile)
+	}
+	var fileProfiles map[string]ConnectionProfile
+	if credentialsFile != "" {
+		fileProfiles, err = readCredentialsFile(credentialsFile)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid credentials file", err.Error())
+			return
+		}
+		for name, profile := range fileProfiles {
+			connectionProfiles[name] = profile
+		}
+	}
 	for _, profile := range data.ConnectionProfiles {
 		var validateCerts bool
 		if profile.ValidateCerts.IsNull() {
@@ -118,13 +307,49 @@ func (p *ONTAPProvider) Configure(ctx context.Context, req provider.ConfigureReq
 			validateCerts = profile.ValidateCerts.ValueBool()
 		}
 		connectionProfiles[profile.Name.ValueString()] = ConnectionProfile{
-			Hostname:              profile.Hostname.ValueString(),
-			Username:              profile.Username.ValueString(),
-			Password:              profile.Password.ValueString(),
-			ValidateCerts:         validateCerts,
-			MaxConcurrentRequests: 0,
+			Hostname:               profile.Hostname.ValueString(),
+			Port:                   int(profile.Port.ValueInt64()),
+			Scheme:                 profile.Scheme.ValueString(),
+			APIRoot:                profile.APIRoot.ValueString(),
+			ProxyURL:               profile.ProxyURL.ValueString(),
+			Username:               profile.Username.ValueString(),
+			Password:               profile.Password.ValueString(),
+			ValidateCerts:          validateCerts,
+			MaxConcurrentRequests:  int(profile.MaxConcurrentRequests.ValueInt64()),
+			PageSize:               int(profile.PageSize.ValueInt64()),
+			RecordLimit:            int(profile.RecordLimit.ValueInt64()),
+			Retry:                  profile.Retry.toRetryPolicy(),
+			ClientCertificate:      profile.ClientCertificate.ValueString(),
+			ClientKey:              profile.ClientKey.ValueString(),
+			CACertificate:          profile.CACertificate.ValueString(),
+			TLSServerName:          profile.TLSServerName.ValueString(),
+			CertificateFingerprint: profile.CertificateFingerprint.ValueString(),
+			MinTLSVersion:          profile.MinTLSVersion.ValueString(),
+			ConnectTimeout:         time.Duration(profile.ConnectTimeout.ValueInt64()) * time.Second,
+			TLSHandshakeTimeout:    time.Duration(profile.TLSHandshakeTimeout.ValueInt64()) * time.Second,
+			ResponseTimeout:        time.Duration(profile.ResponseTimeout.ValueInt64()) * time.Second,
+			ReturnTimeout:          int(profile.ReturnTimeout.ValueInt64()),
 		}
 	}
+	if len(connectionProfiles) == 0 {
+		resp.Diagnostics.AddError("no connection profile",
+			fmt.Sprintf("At least one connection profile must be defined, in connection_profiles, in a credentials file, or with %s.", envHostname))
+		return
+	}
+	defaultFromEnv := envProfile != nil && !definesProfile(data.ConnectionProfiles, fileProfiles, envProfileName)
+	if err := validateConnectionProfiles(ctx, connectionProfiles, defaultFromEnv); err != nil {
+		resp.Diagnostics.AddError("invalid connection profile", err.Error())
+		return
+	}
+	// sensitive attributes are redacted by name in the logs and in the audit log
+	registerSensitiveFields.Do(func() {
+		httpclient.RegisterSensitiveFields(p.sensitiveAttributeNames(ctx)...)
+	})
+	auditLog, err := p.openAuditLog(ctx, data.AuditLogFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid audit log file", err.Error())
+		return
+	}
 	jobCompletionTimeOut := data.JobCompletionTimeOut.ValueInt64()
 	if data.JobCompletionTimeOut.IsNull() {
 		jobCompletionTimeOut = 600
@@ -133,16 +358,164 @@ func (p *ONTAPProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		ConnectionProfiles:   connectionProfiles,
 		JobCompletionTimeOut: int(jobCompletionTimeOut),
 		Version:              p.version,
+		clients:              newClientCache(),
+		auditLog:             auditLog,
 	}
 	resp.DataSourceData = config
 	resp.ResourceData = config
 
 }
 
+// openAuditLog returns the audit log writing to fileName, or nil if fileName is empty.
+// The file is only opened once per provider instance, and the previous file is closed if audit_log_file changes.
+func (p *ONTAPProvider) openAuditLog(ctx context.Context, fileName string) (*restclient.AuditLog, error) {
+	if fileName == p.auditLogFile {
+		return p.auditLog, nil
+	}
+	if p.auditLog != nil {
+		if err := p.auditLog.Close(); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to close audit log %s: %s", p.auditLogFile, err))
+		}
+		p.auditLog, p.auditLogFile = nil, ""
+	}
+	if fileName == "" {
+		return nil, nil
+	}
+	auditLog, err := restclient.OpenAuditLog(fileName)
+	if err != nil {
+		return nil, err
+	}
+	p.auditLog, p.auditLogFile = auditLog, fileName
+	return auditLog, nil
+}
+
+// sensitiveAttributeNames returns the names of the provider and resource attributes marked as sensitive, at any depth
+func (p *ONTAPProvider) sensitiveAttributeNames(ctx context.Context) []string {
+	providerSchema := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &providerSchema)
+	names := sensitiveProviderAttributeNames(providerSchema.Schema.Attributes)
+	for _, newResource := range p.Resources(ctx) {
+		resourceSchema := resource.SchemaResponse{}
+		newResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+		names = append(names, sensitiveResourceAttributeNames(resourceSchema.Schema.Attributes)...)
+	}
+	return names
+}
+
+func sensitiveProviderAttributeNames(attributes map[string]schema.Attribute) []string {
+	var names []string
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			names = append(names, name)
+		}
+		switch nested := attribute.(type) {
+		case schema.ListNestedAttribute:
+			names = append(names, sensitiveProviderAttributeNames(nested.NestedObject.Attributes)...)
+		case schema.SingleNestedAttribute:
+			names = append(names, sensitiveProviderAttributeNames(nested.Attributes)...)
+		}
+	}
+	return names
+}
+
+func sensitiveResourceAttributeNames(attributes map[string]resourceschema.Attribute) []string {
+	var names []string
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			names = append(names, name)
+		}
+		switch nested := attribute.(type) {
+		case resourceschema.ListNestedAttribute:
+			names = append(names, sensitiveResourceAttributeNames(nested.NestedObject.Attributes)...)
+		case resourceschema.SetNestedAttribute:
+			names = append(names, sensitiveResourceAttributeNames(nested.NestedObject.Attributes)...)
+		case resourceschema.MapNestedAttribute:
+			names = append(names, sensitiveResourceAttributeNames(nested.NestedObject.Attributes)...)
+		case resourceschema.SingleNestedAttribute:
+			names = append(names, sensitiveResourceAttributeNames(nested.Attributes)...)
+		}
+	}
+	return names
+}
+
+// definesProfile returns true if name is defined in connection_profiles or in the credentials file
+func definesProfile(hclProfiles []ConnectionProfileModel, fileProfiles map[string]ConnectionProfile, name string) bool {
+	if _, ok := fileProfiles[name]; ok {
+		return true
+	}
+	for _, profile := range hclProfiles {
+		if profile.Name.ValueString() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateConnectionProfiles checks the authentication settings of each profile.
+// When other profiles are defined, the default profile from the environment is only a fallback: it is removed with a
+// warning if it is incomplete, so that a stray NETAPP_ONTAP_HOSTNAME does not break a configuration that does not use it.
+func validateConnectionProfiles(ctx context.Context, profiles map[string]ConnectionProfile, defaultFromEnv bool) error {
+	for name, profile := range profiles {
+		err := validateAuthentication(profile)
+		if err == nil {
+			continue
+		}
+		if name == envProfileName && defaultFromEnv && len(profiles) > 1 {
+			tflog.Warn(ctx, fmt.Sprintf("ignoring connection profile %s defined with %s: %s", name, envHostname, err))
+			delete(profiles, name)
+			continue
+		}
+		return fmt.Errorf("connection profile %s: %s", name, err)
+	}
+	return nil
+}
+
+// validateAuthentication checks that either basic or certificate authentication is configured
+func validateAuthentication(profile ConnectionProfile) error {
+	hasCert := profile.ClientCertificate != ""
+	hasKey := profile.ClientKey != ""
+	if hasCert != hasKey {
+		return fmt.Errorf("client_certificate and client_key are required together for certificate authentication")
+	}
+	if hasCert {
+		return nil
+	}
+	if profile.Username == "" || profile.Password == "" {
+		return fmt.Errorf("username and password are required, unless client_certificate and client_key are set")
+	}
+	return nil
+}
+
+// toRetryPolicy converts the retry block, unset values are replaced with defaults when creating the REST client.
+func (m *RetryModel) toRetryPolicy() restclient.RetryPolicy {
+	var policy restclient.RetryPolicy
+	if m == nil {
+		return policy
+	}
+	policy.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	policy.InitialBackoff = time.Duration(m.InitialBackoff.ValueInt64()) * time.Second
+	policy.MaxBackoff = time.Duration(m.MaxBackoff.ValueInt64()) * time.Second
+	if m.RetryableStatusCodes != nil {
+		policy.RetryableStatusCodes = make([]int, len(m.RetryableStatusCodes))
+		for index, code := range m.RetryableStatusCodes {
+			policy.RetryableStatusCodes[index] = int(code.ValueInt64())
+		}
+	}
+	if m.RetryableErrorCodes != nil {
+		policy.RetryableErrorCodes = make([]string, len(m.RetryableErrorCodes))
+		for index, code := range m.RetryableErrorCodes {
+			policy.RetryableErrorCodes[index] = code.ValueString()
+		}
+	}
+	policy.RetryNonIdempotent = m.RetryNonIdempotent.ValueBool()
+	return policy
+}
+
 // Resources defines the provider's resources.
 func (p *ONTAPProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAggregateResource,
+		NewCLICommandResource,
 		NewClusterLicensingLicenseResource,
 		NewClusterScheduleResource,
 		NewExampleResource,
@@ -151,7 +524,16 @@ func (p *ONTAPProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewIPInterfaceResource,
 		NewIPRouteResource,
 		NewNameServicesDNSResource,
+		NewProtocolsCifsServiceResource,
+		NewProtocolsCifsShareResource,
+		NewProtocolsCifsShareACLResource,
 		NewProtocolsNfsServiceResource,
+		NewProtocolsSanFcpServiceResource,
+		NewProtocolsSanIscsiServiceResource,
+		NewRestAPIResource,
+		NewSanIgroupResource,
+		NewSanLunResource,
+		NewSanLunMapResource,
 		NewSnapmirrorResource,
 		NewSnapmirrorPolicyResource,
 		NewSnapshotPolicyResource,
@@ -180,7 +562,20 @@ func (p *ONTAPProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewIPRoutesDataSource,
 		NewNameServicesDNSDataSource,
 		NewNameServicesDNSsDataSource,
+		NewProtocolsCifsServiceDataSource,
+		NewProtocolsCifsServicesDataSource,
+		NewProtocolsCifsShareDataSource,
+		NewProtocolsCifsSharesDataSource,
+		NewProtocolsCifsShareACLDataSource,
+		NewProtocolsCifsShareACLsDataSource,
 		NewProtocolsNfsServiceDataSource,
+		NewProtocolsSanFcpServiceDataSource,
+		NewProtocolsSanFcpServicesDataSource,
+		NewProtocolsSanIscsiServiceDataSource,
+		NewProtocolsSanIscsiServicesDataSource,
+		NewRestAPIDataSource,
+		NewSanIgroupDataSource,
+		NewSanIgroupsDataSource,
 		NewSnapmirrorDataSource,
 		NewSnapmirrorsDataSource,
 		NewSnapshotPoliciesDataSource,
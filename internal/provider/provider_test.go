@@ -1,10 +1,17 @@
 package provider
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/replay"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -20,3 +27,209 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccHostVariables hold the clusters used by acceptance tests
+var testAccHostVariables = []string{"TF_ACC_NETAPP_HOST", "TF_ACC_NETAPP_HOST2", "TF_ACC_NETAPP_HOST3"}
+
+// testAccSecretVariables are replaced with placeholders in cassettes, and set to testAccReplayValue when replaying
+var testAccSecretVariables = []string{"TF_ACC_NETAPP_PASS", "TF_ACC_NETAPP_LICENSE", "TF_ACC_NETAPP_AD_PASS"}
+
+const testAccReplayValue = "replay"
+
+// testAccReplay selects how an acceptance test reaches ONTAP, it must be called before building the test configurations.
+//   - with TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_HOST2 or TF_ACC_NETAPP_HOST3 set, the test runs against the clusters.
+//     With TF_ACC_NETAPP_RECORD also set, the REST calls go through a local proxy, and are saved to testdata/cassettes/<test name>.json
+//     when the test succeeds.
+//   - otherwise, the REST calls are replayed from the cassette by a local server, so the test runs offline.
+//     A request that does not match the cassette, or a recorded request that is not sent, fails the test.
+//     The test is skipped if there is no cassette.
+func testAccReplay(t *testing.T) {
+	fileName := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	for _, name := range testAccHostVariables {
+		if os.Getenv(name) != "" {
+			if os.Getenv("TF_ACC_NETAPP_RECORD") != "" {
+				testAccRecord(t, fileName)
+			}
+			return
+		}
+	}
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+	cassette, err := replay.LoadCassette(fileName)
+	if os.IsNotExist(err) {
+		// a missing cassette is an error in CI, so that the replay job does not silently test nothing
+		if os.Getenv("CI") != "" {
+			t.Fatalf("TF_ACC_NETAPP_HOST is not set, and there is no cassette %s, record it with make testacc-record", fileName)
+		}
+		t.Skipf("TF_ACC_NETAPP_HOST is not set, and there is no cassette %s", fileName)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]string{}
+	for _, name := range testAccSecretVariables {
+		values[name] = testAccReplayValue
+		t.Setenv(name, testAccReplayValue)
+	}
+	t.Setenv("TF_ACC_NETAPP_USER", "admin")
+	for _, name := range cassette.Servers() {
+		server := replay.NewServer(cassette, name, values)
+		t.Setenv(name, server.Host())
+		t.Cleanup(func() {
+			server.Close()
+			for _, err := range server.Errors() {
+				t.Error(err)
+			}
+			if t.Failed() {
+				return
+			}
+			for _, interaction := range server.Unused() {
+				t.Errorf("replay: recorded interaction not sent to %s: %s", interaction.Server, interaction.Request)
+			}
+		})
+	}
+}
+
+// testAccRecord records the REST calls sent to each cluster into a cassette
+func testAccRecord(t *testing.T, fileName string) {
+	secrets := map[string]string{}
+	for _, name := range append(testAccHostVariables, testAccSecretVariables...) {
+		if value := os.Getenv(name); value != "" {
+			secrets[name] = value
+		}
+	}
+	cassette := &replay.Cassette{}
+	var recorders []*replay.Recorder
+	for _, name := range testAccHostVariables {
+		if host := os.Getenv(name); host != "" {
+			recorder := replay.NewRecorder(cassette, name, host, secrets)
+			recorders = append(recorders, recorder)
+			t.Setenv(name, recorder.Host())
+		}
+	}
+	t.Cleanup(func() {
+		for _, recorder := range recorders {
+			recorder.Close()
+			for _, err := range recorder.Errors() {
+				t.Error(err)
+			}
+		}
+		if t.Failed() || t.Skipped() {
+			return
+		}
+		if err := cassette.Save(fileName); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestValidateAuthentication(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile ConnectionProfile
+		wantErr bool
+	}{
+		{name: "test_basic", profile: ConnectionProfile{Username: "admin", Password: "secret"}, wantErr: false},
+		{name: "test_certificate", profile: ConnectionProfile{ClientCertificate: "admin.pem", ClientKey: "admin.key"}, wantErr: false},
+		{name: "test_no_password", profile: ConnectionProfile{Username: "admin"}, wantErr: true},
+		{name: "test_no_key", profile: ConnectionProfile{ClientCertificate: "admin.pem"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAuthentication(tt.profile); (err != nil) != tt.wantErr {
+				t.Errorf("validateAuthentication() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateConnectionProfiles(t *testing.T) {
+	basic := ConnectionProfile{Hostname: "10.10.10.10", Username: "admin", Password: "secret"}
+	noPassword := ConnectionProfile{Hostname: "10.10.10.11"}
+	tests := []struct {
+		name           string
+		profiles       map[string]ConnectionProfile
+		defaultFromEnv bool
+		want           []string
+		wantErr        bool
+	}{
+		{name: "test_valid", profiles: map[string]ConnectionProfile{"default": basic, "cluster1": basic}, defaultFromEnv: true, want: []string{"cluster1", "default"}, wantErr: false},
+		{name: "test_unused_env_profile", profiles: map[string]ConnectionProfile{"default": noPassword, "cluster1": basic}, defaultFromEnv: true, want: []string{"cluster1"}, wantErr: false},
+		{name: "test_only_env_profile", profiles: map[string]ConnectionProfile{"default": noPassword}, defaultFromEnv: true, want: nil, wantErr: true},
+		{name: "test_default_not_from_env", profiles: map[string]ConnectionProfile{"default": noPassword, "cluster1": basic}, defaultFromEnv: false, want: nil, wantErr: true},
+		{name: "test_invalid_profile", profiles: map[string]ConnectionProfile{"default": basic, "cluster1": noPassword}, defaultFromEnv: true, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConnectionProfiles(context.Background(), tt.profiles, tt.defaultFromEnv)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateConnectionProfiles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			var got []string
+			for name := range tt.profiles {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateConnectionProfiles() profiles = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestONTAPProvider_openAuditLog(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &ONTAPProvider{version: "test"}
+	first, err := p.openAuditLog(ctx, filepath.Join(dir, "audit1.log"))
+	if err != nil || first == nil {
+		t.Fatalf("ONTAPProvider.openAuditLog() = %v, error = %v", first, err)
+	}
+	// Configure is called again with the same file
+	if again, err := p.openAuditLog(ctx, filepath.Join(dir, "audit1.log")); err != nil || again != first {
+		t.Errorf("ONTAPProvider.openAuditLog() = %p, error = %v, want the same audit log %p", again, err, first)
+	}
+	second, err := p.openAuditLog(ctx, filepath.Join(dir, "audit2.log"))
+	if err != nil || second == nil || second == first {
+		t.Fatalf("ONTAPProvider.openAuditLog() = %p, error = %v, want a new audit log", second, err)
+	}
+	if err := first.Record(restclient.AuditEntry{Method: "POST"}); err == nil {
+		t.Errorf("AuditLog.Record() expected an error, the previous file is closed")
+	}
+	if none, err := p.openAuditLog(ctx, ""); err != nil || none != nil {
+		t.Errorf("ONTAPProvider.openAuditLog() = %v, error = %v, want nil", none, err)
+	}
+	if err := second.Record(restclient.AuditEntry{Method: "POST"}); err == nil {
+		t.Errorf("AuditLog.Record() expected an error, the file is closed when audit_log_file is removed")
+	}
+}
+
+func TestSensitiveAttributeNames(t *testing.T) {
+	p := &ONTAPProvider{version: "test"}
+	names := map[string]bool{}
+	for _, name := range p.sensitiveAttributeNames(context.Background()) {
+		names[name] = true
+	}
+	tests := []struct {
+		name      string
+		attribute string
+		want      bool
+	}{
+		{name: "test_provider_password", attribute: "password", want: true},
+		{name: "test_provider_proxy_url", attribute: "proxy_url", want: true},
+		{name: "test_license_keys", attribute: "keys", want: true},
+		{name: "test_not_sensitive", attribute: "hostname", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if names[tt.attribute] != tt.want {
+				t.Errorf("sensitiveAttributeNames() includes %s = %v, want %v", tt.attribute, names[tt.attribute], tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,17 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
@@ -25,6 +35,82 @@ func getRestClient(errorHandler *utils.ErrorHandler, config resourceOrDataSource
 	return config.client, nil
 }
 
+// getRestClientWithTimeout returns a client waiting up to timeout for jobs to complete, rather than job_completion_timeout
+// timeout is read from the timeouts block of the resource, for the current operation
+func getRestClientWithTimeout(errorHandler *utils.ErrorHandler, config resourceOrDataSourceConfig, cxProfileName types.String, timeout time.Duration) (*restclient.RestClient, error) {
+	client, err := getRestClient(errorHandler, config, cxProfileName)
+	if err != nil {
+		return nil, err
+	}
+	return client.WithJobCompletionTimeOut(int(timeout.Seconds())), nil
+}
+
+// defaultTimeout is used when the operation is not set in the timeouts block of a resource
+func (c resourceOrDataSourceConfig) defaultTimeout() time.Duration {
+	return time.Duration(c.providerConfig.JobCompletionTimeOut) * time.Second
+}
+
+// checkONTAPVersion reports an error for each feature requiring a more recent ONTAP version than the cluster version.
+// requirements maps a feature, eg "attribute sync_type", to the minimum ONTAP version, eg "9.10.1".
+// The cluster is only contacted if there is at least one requirement, and the version is cached per connection profile.
+func checkONTAPVersion(errorHandler *utils.ErrorHandler, config resourceOrDataSourceConfig, cxProfileName types.String, requirements map[string]string) error {
+	if len(requirements) == 0 || cxProfileName.IsUnknown() {
+		return nil
+	}
+	client, err := getRestClient(errorHandler, config, cxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return err
+	}
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterInfo
+		return err
+	}
+	features := make([]string, 0, len(requirements))
+	for feature := range requirements {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+	var unsupported error
+	for _, feature := range features {
+		minimum, err := restclient.ParseClusterVersion(requirements[feature])
+		if err != nil {
+			return errorHandler.MakeAndReportError("invalid ONTAP version requirement", err.Error())
+		}
+		if !cluster.Version.AtLeast(minimum) {
+			unsupported = errorHandler.MakeAndReportError("unsupported ONTAP version", fmt.Sprintf("%s requires ONTAP %s, cluster is %s", feature, minimum, cluster.Version))
+		}
+	}
+	return unsupported
+}
+
+// removeResourceNotFound removes a resource from the state when it no longer exists on the cluster, eg when it was deleted outside of Terraform.
+// Terraform then plans to create it again.
+func removeResourceNotFound(ctx context.Context, resp *resource.ReadResponse, kind string, name string) {
+	msg := fmt.Sprintf("%s %s was not found on the cluster, it may have been deleted outside of Terraform. It is removed from the state, and will be created again on the next apply.", kind, name)
+	tflog.Warn(ctx, msg)
+	resp.Diagnostics.AddWarning(fmt.Sprintf("%s not found", kind), msg)
+	resp.State.RemoveResource(ctx)
+}
+
+// splitImportID splits a composite import identifier, eg svm_name,volume_name,cx_profile_name, into its parts.
+// format lists the expected parts, separated by commas.  An error is reported if a part is missing or empty.
+func splitImportID(id string, format string, diags *diag.Diagnostics) ([]string, bool) {
+	parts := strings.Split(id, ",")
+	if len(parts) != len(strings.Split(format, ",")) {
+		diags.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, id))
+		return nil, false
+	}
+	for _, part := range parts {
+		if part == "" {
+			diags.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, id))
+			return nil, false
+		}
+	}
+	return parts, true
+}
+
 // func flattenTypesInt64List(clist []int64) interface{} {
 func flattenTypesInt64List(clist []int64) []types.Int64 {
 	if len(clist) == 0 {
@@ -50,3 +136,64 @@ func flattenTypesStringList(terraformStringsList []string) []types.String {
 
 	return stringsList
 }
+
+// maskSensitiveValues returns a context masking values in the log messages and fields, for attributes marked as sensitive.
+// Sensitive fields in REST request and response bodies are redacted by name by the HTTP client.
+func maskSensitiveValues(ctx context.Context, values []types.String) context.Context {
+	var masked []string
+	for _, value := range values {
+		if value.ValueString() != "" {
+			masked = append(masked, value.ValueString())
+		}
+	}
+	if len(masked) == 0 {
+		return ctx
+	}
+	return tflog.MaskLogStrings(ctx, masked...)
+}
+
+// knownBool returns a pointer to the value, or nil if it is null or unknown, so that it is omitted from a request body
+func knownBool(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	boolValue := value.ValueBool()
+	return &boolValue
+}
+
+// stringValues returns the values of a list or set attribute, an empty list is returned rather than nil so that it can be sent to clear a field
+func stringValues(values []types.String) []string {
+	stringsList := make([]string, 0, len(values))
+	for _, value := range values {
+		stringsList = append(stringsList, value.ValueString())
+	}
+	return stringsList
+}
+
+// equalFoldStringValue returns the current value if it only differs from the ONTAP value by case, eg for NetBIOS names and domains
+func equalFoldStringValue(current types.String, value string) types.String {
+	if strings.EqualFold(current.ValueString(), value) && !current.IsNull() && !current.IsUnknown() {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// equalFoldStringSets returns true if both sets have the same elements, ignoring case
+func equalFoldStringSets(current []types.String, values []string) bool {
+	if len(current) != len(values) {
+		return false
+	}
+	for _, value := range values {
+		found := false
+		for _, element := range current {
+			if strings.EqualFold(element.ValueString(), value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestCheckONTAPVersion(t *testing.T) {
+	record := map[string]any{"name": "cluster1", "uuid": "1234", "version": map[string]any{"full": "NetApp Release 9.8.0", "generation": 9.0, "major": 8.0, "minor": 0.0}}
+	clusterResponse := restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}, Err: nil}
+	tests := []struct {
+		name         string
+		responses    []restclient.MockResponse
+		requirements map[string]string
+		wantErr      bool
+		wantDetail   string
+	}{
+		{name: "test_no_requirement", responses: nil, requirements: map[string]string{}, wantErr: false},
+		{name: "test_supported", responses: []restclient.MockResponse{clusterResponse}, requirements: map[string]string{"attribute sync_type": "9.8.0"}, wantErr: false},
+		{name: "test_unsupported", responses: []restclient.MockResponse{clusterResponse}, requirements: map[string]string{"attribute sync_type": "9.10.1"}, wantErr: true,
+			wantDetail: "attribute sync_type requires ONTAP 9.10.1, cluster is 9.8.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			diags := diag.Diagnostics{}
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+			config := resourceOrDataSourceConfig{client: client, name: "test"}
+			err = checkONTAPVersion(errorHandler, config, types.StringValue("cluster1"), tt.requirements)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkONTAPVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantDetail != "" && (len(diags) != 1 || diags[0].Detail() != tt.wantDetail) {
+				t.Errorf("checkONTAPVersion() diagnostics = %v, want %s", diags, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestRemoveResourceNotFound(t *testing.T) {
+	notFound := restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: "svm/svms/1234", StatusCode: 404, Response: restclient.RestResponse{},
+		Err: &restclient.ONTAPError{Code: "4", Message: "entry doesn't exist", StatusCode: 404}}
+	serverError := restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: "svm/svms/1234", StatusCode: 500, Response: restclient.RestResponse{},
+		Err: &restclient.ONTAPError{StatusCode: 500}}
+	tests := []struct {
+		name        string
+		responses   []restclient.MockResponse
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "test_not_found", responses: []restclient.MockResponse{notFound}, wantRemoved: true, wantErr: false},
+		{name: "test_server_error", responses: []restclient.MockResponse{serverError}, wantRemoved: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client, err := restclient.NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			r := NewSvmResource().(*SvmResource)
+			r.config.client = client
+			r.config.providerConfig.JobCompletionTimeOut = 60
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := state.SetAttribute(ctx, path.Root("id"), "1234")
+			diags.Append(state.SetAttribute(ctx, path.Root("name"), "svm1")...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error setting state: %v", diags)
+			}
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("SvmResource.Read() diags = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Errorf("SvmResource.Read() state = %v, wantRemoved %v", resp.State.Raw, tt.wantRemoved)
+			}
+			if tt.wantRemoved && resp.Diagnostics.WarningsCount() != 1 {
+				t.Errorf("SvmResource.Read() expected a warning, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		format  string
+		want    []string
+		wantErr bool
+	}{
+		{name: "test_valid", id: "svm1,vol1,cluster1", format: "svm_name,volume_name,cx_profile_name", want: []string{"svm1", "vol1", "cluster1"}, wantErr: false},
+		{name: "test_missing_part", id: "svm1,vol1", format: "svm_name,volume_name,cx_profile_name", want: nil, wantErr: true},
+		{name: "test_empty_part", id: "svm1,,cluster1", format: "svm_name,volume_name,cx_profile_name", want: nil, wantErr: true},
+		{name: "test_uuid", id: "1234", format: "svm_name,volume_name,cx_profile_name", want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got, ok := splitImportID(tt.id, tt.format, &diags)
+			if ok == tt.wantErr || diags.HasError() != tt.wantErr {
+				t.Errorf("splitImportID() ok = %v, diags = %v, wantErr %v", ok, diags, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitImportID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStorageVolumeResource_ImportState(t *testing.T) {
+	svmRecord := map[string]any{"name": "svm1", "uuid": "svm1234"}
+	volumeRecord := map[string]any{"name": "vol1", "uuid": "vol1234", "svm": map[string]any{"name": "svm1"}}
+	responses := []restclient.MockResponse{
+		{ExpectedMethod: "GET", ExpectedURL: "svm/svms", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{svmRecord}}, Err: nil},
+		{ExpectedMethod: "GET", ExpectedURL: "storage/volumes", StatusCode: 200, Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{volumeRecord}}, Err: nil},
+	}
+	ctx := context.Background()
+	client, err := restclient.NewMockedRestClient(t, responses)
+	if err != nil {
+		panic(err)
+	}
+	r := NewStorageVolumeResource().(*StorageVolumeResource)
+	r.config.client = client
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "svm1,vol1,cluster1"}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("StorageVolumeResource.ImportState() unexpected error: %v", resp.Diagnostics)
+	}
+	var data StorageVolumeResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading state: %v", resp.Diagnostics)
+	}
+	if data.ID.ValueString() != "vol1234" || data.Name.ValueString() != "vol1" || data.SVMName.ValueString() != "svm1" || data.CxProfileName.ValueString() != "cluster1" {
+		t.Errorf("StorageVolumeResource.ImportState() unexpected state: %#v", data)
+	}
+}
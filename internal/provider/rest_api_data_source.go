@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &RestAPIDataSource{}
+
+// NewRestAPIDataSource is a helper function to simplify the provider implementation.
+func NewRestAPIDataSource() datasource.DataSource {
+	return &RestAPIDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "rest_api_data_source",
+		},
+	}
+}
+
+// RestAPIDataSource defines the data source implementation.
+type RestAPIDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// RestAPIDataSourceModel describes the data source data model.
+type RestAPIDataSourceModel struct {
+	CxProfileName types.String            `tfsdk:"cx_profile_name"`
+	API           types.String            `tfsdk:"api"`
+	Query         map[string]types.String `tfsdk:"query"`
+	Fields        []types.String          `tfsdk:"fields"`
+	RecordsJSON   types.String            `tfsdk:"records_json"`
+	Records       []map[string]string     `tfsdk:"records"`
+}
+
+// Metadata returns the data source type name.
+func (d *RestAPIDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *RestAPIDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads the records of any ONTAP REST API collection, for objects that have no dedicated data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"api": schema.StringAttribute{
+				MarkdownDescription: "API path, relative to /api, eg storage/disks",
+				Required:            true,
+			},
+			"query": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Query parameters, eg to filter the records",
+				Optional:            true,
+			},
+			"fields": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of fields to read, ONTAP returns a default set of fields if not set",
+				Optional:            true,
+			},
+			"records_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded list of records, to be decoded with jsondecode",
+				Computed:            true,
+			},
+			"records": schema.ListAttribute{
+				ElementType:         types.MapType{ElemType: types.StringType},
+				MarkdownDescription: "List of records. Nested objects use dot separated keys, eg node.name, lists are JSON encoded",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RestAPIDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RestAPIDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RestAPIDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	query := map[string]string{}
+	for key, value := range data.Query {
+		query[key] = value.ValueString()
+	}
+	var fields []string
+	for _, field := range data.Fields {
+		fields = append(fields, field.ValueString())
+	}
+	records, err := interfaces.GetRestAPIRecords(errorHandler, *client, data.API.ValueString(), query, fields)
+	if err != nil {
+		// error reporting done inside GetRestAPIRecords
+		return
+	}
+
+	recordsJSON, err := json.Marshal(records)
+	if err != nil {
+		errorHandler.MakeAndReportError("error encoding records", fmt.Sprintf("error encoding records from %s: %s", data.API.ValueString(), err))
+		return
+	}
+	data.RecordsJSON = types.StringValue(string(recordsJSON))
+	data.Records = make([]map[string]string, len(records))
+	for index, record := range records {
+		data.Records[index] = interfaces.FlattenRestAPIRecord(record)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
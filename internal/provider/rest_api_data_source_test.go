@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRestAPIDataSource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIDataSourceConfig("svm/svms", "ansibleSVM"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netapp-ontap_rest_api_data_source.example", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.netapp-ontap_rest_api_data_source.example", "records.0.name", "ansibleSVM"),
+					resource.TestCheckResourceAttrSet("data.netapp-ontap_rest_api_data_source.example", "records.0.ipspace.name"),
+					resource.TestMatchResourceAttr("data.netapp-ontap_rest_api_data_source.example", "records_json", regexp.MustCompile(`"name":"ansibleSVM"`)),
+				),
+			},
+			// non-existant API
+			{
+				Config:      testAccRestAPIDataSourceConfig("svm/non-existant", "ansibleSVM"),
+				ExpectError: regexp.MustCompile("error reading svm/non-existant"),
+			},
+		},
+	})
+}
+
+func testAccRestAPIDataSourceConfig(api string, svmName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+data "netapp-ontap_rest_api_data_source" "example" {
+  cx_profile_name = "cluster4"
+  api = "%s"
+  query = {
+    name = "%s"
+  }
+  fields = ["name", "ipspace.name", "state"]
+}
+`, host, admin, password, api, svmName)
+}
@@ -0,0 +1,465 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &RestAPIResource{}
+
+var _ resource.ResourceWithValidateConfig = &RestAPIResource{}
+
+var _ resource.ResourceWithModifyPlan = &RestAPIResource{}
+
+// NewRestAPIResource is a helper function to simplify the provider implementation.
+func NewRestAPIResource() resource.Resource {
+	return &RestAPIResource{
+		config: resourceOrDataSourceConfig{
+			name: "rest_api_resource",
+		},
+	}
+}
+
+// RestAPIResource defines the resource implementation.
+type RestAPIResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// RestAPIResourceModel describes the resource data model.
+type RestAPIResourceModel struct {
+	CxProfileName types.String            `tfsdk:"cx_profile_name"`
+	API           types.String            `tfsdk:"api"`
+	CreateBody    types.String            `tfsdk:"create_body"`
+	PatchBody     types.String            `tfsdk:"patch_body"`
+	KeyQuery      map[string]types.String `tfsdk:"key_query"`
+	DeletePath    types.String            `tfsdk:"delete_path"`
+	PatchPath     types.String            `tfsdk:"patch_path"`
+	DriftFields   []types.String          `tfsdk:"drift_fields"`
+	Record        types.Map               `tfsdk:"record"`
+	ID            types.String            `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *RestAPIResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *RestAPIResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages any ONTAP object through the REST API, for objects that have no dedicated resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"api": schema.StringAttribute{
+				MarkdownDescription: "Collection path, relative to /api, eg storage/qtrees",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded body of the POST request used to create the object, eg with jsonencode. A change recreates the object",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"patch_body": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded body of the PATCH request sent after create, and when it changes",
+				Optional:            true,
+			},
+			"key_query": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Query parameters matching a single record in the collection, used to find the object after create, eg name and svm.name",
+				Required:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the object used for DELETE, relative to /api. Fields of the record can be used as placeholders, eg storage/qtrees/{volume.uuid}/{id}",
+				Required:            true,
+			},
+			"patch_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the object used for PATCH, with the same placeholders as delete_path. Defaults to delete_path",
+				Optional:            true,
+			},
+			"drift_fields": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Dot separated fields compared with create_body and patch_body during refresh. A difference in a patch_body field plans an update, a difference in a create_body field plans a replacement",
+				Optional:            true,
+			},
+			"record": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Fields read from the object, for drift_fields and the path placeholders. Nested objects use dot separated keys",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Path of the object, resolved from delete_path",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RestAPIResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected  Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ValidateConfig checks that the bodies are JSON objects
+func (r *RestAPIResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RestAPIResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for attribute, body := range map[string]types.String{"create_body": data.CreateBody, "patch_body": data.PatchBody} {
+		if body.IsNull() || body.IsUnknown() {
+			continue
+		}
+		if _, err := interfaces.DecodeRestAPIBody(body.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid JSON body", fmt.Sprintf("%s: %s", attribute, err))
+		}
+	}
+}
+
+// ModifyPlan marks id as unknown when delete_path changes, as id is resolved from delete_path during the update
+func (r *RestAPIResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *RestAPIResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// plan is null when the resource is destroyed
+	if plan == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// state is null when the resource is created
+	if state == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.DeletePath.Equal(state.DeletePath) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
+// fields returns the fields to read, for drift detection and to resolve the paths
+func (data *RestAPIResourceModel) fields() []string {
+	fields := interfaces.RestAPIPathFields(data.DeletePath.ValueString())
+	fields = append(fields, interfaces.RestAPIPathFields(data.PatchPath.ValueString())...)
+	for _, field := range data.DriftFields {
+		fields = append(fields, field.ValueString())
+	}
+	return fields
+}
+
+// keyQuery returns the query parameters matching the object
+func (data *RestAPIResourceModel) keyQuery() map[string]string {
+	query := map[string]string{}
+	for key, value := range data.KeyQuery {
+		query[key] = value.ValueString()
+	}
+	return query
+}
+
+// readObject reads the object matching key_query, and sets id and record.  nil is returned if the object is not found.
+func (r *RestAPIResource) readObject(ctx context.Context, errorHandler *utils.ErrorHandler, client *restclient.RestClient, data *RestAPIResourceModel) (map[string]interface{}, error) {
+	record, err := interfaces.GetRestAPIObject(errorHandler, *client, data.API.ValueString(), data.keyQuery(), data.fields())
+	if err != nil || record == nil {
+		return nil, err
+	}
+	id, err := interfaces.ResolveRestAPIPath(data.DeletePath.ValueString(), record)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error resolving delete_path", err.Error())
+	}
+	data.ID = types.StringValue(id)
+	flattened := interfaces.FlattenRestAPIRecord(record)
+	values := map[string]string{}
+	for _, field := range data.fields() {
+		if value, ok := flattened[field]; ok {
+			values[field] = value
+		}
+	}
+	recordValue, diags := types.MapValueFrom(ctx, types.StringType, values)
+	if diags.HasError() {
+		return nil, errorHandler.MakeAndReportError("error setting record", fmt.Sprintf("error converting record %v: %v", values, diags))
+	}
+	data.Record = recordValue
+	return record, nil
+}
+
+// patchPath returns the resolved path used for PATCH
+func (data *RestAPIResourceModel) patchPath(record map[string]interface{}) (string, error) {
+	if data.PatchPath.IsNull() {
+		return data.ID.ValueString(), nil
+	}
+	return interfaces.ResolveRestAPIPath(data.PatchPath.ValueString(), record)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *RestAPIResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RestAPIResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	record, err := r.readObject(ctx, errorHandler, client, &data)
+	if err != nil {
+		return
+	}
+	if record == nil {
+		removeResourceNotFound(ctx, resp, data.API.ValueString(), data.ID.ValueString())
+		return
+	}
+
+	// report drift by copying the current values to the body holding the field, so that Terraform plans a change
+	var driftFields []string
+	for _, field := range data.DriftFields {
+		driftFields = append(driftFields, field.ValueString())
+	}
+	patchBody, err := interfaces.DecodeRestAPIBody(data.PatchBody.ValueString())
+	if err != nil {
+		errorHandler.MakeAndReportError("error decoding patch_body", err.Error())
+		return
+	}
+	createBody, err := interfaces.DecodeRestAPIBody(data.CreateBody.ValueString())
+	if err != nil {
+		errorHandler.MakeAndReportError("error decoding create_body", err.Error())
+		return
+	}
+	patchDrift := interfaces.RestAPIDrift(patchBody, record, driftFields)
+	for _, field := range patchDrift {
+		interfaces.SetRestAPIValue(patchBody, record, field)
+	}
+	// a field set in patch_body is only compared with patch_body, as PATCH overrides the value used for create
+	patchFields := interfaces.FlattenRestAPIRecord(patchBody)
+	var createDrift []string
+	for _, field := range interfaces.RestAPIDrift(createBody, record, driftFields) {
+		if _, ok := patchFields[field]; !ok {
+			createDrift = append(createDrift, field)
+			interfaces.SetRestAPIValue(createBody, record, field)
+		}
+	}
+	if len(patchDrift) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("%s: fields %s were changed outside of Terraform", data.ID.ValueString(), strings.Join(patchDrift, ", ")))
+		data.PatchBody = encodeRestAPIBody(errorHandler, patchBody)
+	}
+	if len(createDrift) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("%s: fields %s were changed outside of Terraform", data.ID.ValueString(), strings.Join(createDrift, ", ")))
+		data.CreateBody = encodeRestAPIBody(errorHandler, createBody)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// encodeRestAPIBody encodes a body in JSON
+func encodeRestAPIBody(errorHandler *utils.ErrorHandler, body map[string]interface{}) types.String {
+	content, err := json.Marshal(body)
+	if err != nil {
+		errorHandler.MakeAndReportError("error encoding body", err.Error())
+		return types.StringNull()
+	}
+	return types.StringValue(string(content))
+}
+
+// Create a resource and retrieve its path
+func (r *RestAPIResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RestAPIResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createBody, err := interfaces.DecodeRestAPIBody(data.CreateBody.ValueString())
+	if err != nil {
+		errorHandler.MakeAndReportError("error decoding create_body", err.Error())
+		return
+	}
+	patchBody, err := interfaces.DecodeRestAPIBody(data.PatchBody.ValueString())
+	if err != nil {
+		errorHandler.MakeAndReportError("error decoding patch_body", err.Error())
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err := interfaces.CreateRestAPIObject(errorHandler, *client, data.API.ValueString(), createBody); err != nil {
+		return
+	}
+	record, err := r.readObject(ctx, errorHandler, client, data)
+	if err != nil {
+		return
+	}
+	if record == nil {
+		errorHandler.MakeAndReportError("error reading created object", fmt.Sprintf("no record found in %s matching key_query %v after create", data.API.ValueString(), data.keyQuery()))
+		return
+	}
+
+	if patchBody != nil {
+		patchPath, err := data.patchPath(record)
+		if err != nil {
+			errorHandler.MakeAndReportError("error resolving patch_path", err.Error())
+			return
+		}
+		if err := interfaces.UpdateRestAPIObject(errorHandler, *client, patchPath, patchBody); err != nil {
+			return
+		}
+		if _, err := r.readObject(ctx, errorHandler, client, data); err != nil {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, path=%s", data.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *RestAPIResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RestAPIResourceModel
+	var state *RestAPIResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		return
+	}
+
+	record, err := r.readObject(ctx, errorHandler, client, data)
+	if err != nil {
+		return
+	}
+	if record == nil {
+		errorHandler.MakeAndReportError("error reading object", fmt.Sprintf("no record found in %s matching key_query %v", data.API.ValueString(), data.keyQuery()))
+		return
+	}
+
+	if !data.PatchBody.Equal(state.PatchBody) && !data.PatchBody.IsNull() {
+		patchBody, err := interfaces.DecodeRestAPIBody(data.PatchBody.ValueString())
+		if err != nil {
+			errorHandler.MakeAndReportError("error decoding patch_body", err.Error())
+			return
+		}
+		patchPath, err := data.patchPath(record)
+		if err != nil {
+			errorHandler.MakeAndReportError("error resolving patch_path", err.Error())
+			return
+		}
+		if err := interfaces.UpdateRestAPIObject(errorHandler, *client, patchPath, patchBody); err != nil {
+			return
+		}
+		if _, err := r.readObject(ctx, errorHandler, client, data); err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *RestAPIResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RestAPIResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("ID is null", "rest_api_resource ID is null")
+		return
+	}
+
+	// delete_path may have changed since the object was read
+	record, err := r.readObject(ctx, errorHandler, client, data)
+	if err != nil {
+		return
+	}
+	if record == nil {
+		tflog.Info(ctx, fmt.Sprintf("%s was already deleted", data.ID.ValueString()))
+		return
+	}
+
+	err = interfaces.DeleteRestAPIObject(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRestAPIResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid create_body
+			{
+				Config:      testAccRestAPIResourceConfig(`"acc_test_qtree"`, "unix"),
+				ExpectError: regexp.MustCompile("Invalid JSON body"),
+			},
+			// create a qtree, and set its security style after create
+			{
+				Config: testAccRestAPIResourceConfig(`jsonencode({name = "acc_test_qtree", svm = {name = "carchi-test"}, volume = {name = "carchi_test_root"}})`, "unix"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("netapp-ontap_rest_api_resource.example", "id", regexp.MustCompile(`^storage/qtrees/[0-9a-f-]+/[0-9]+$`)),
+					resource.TestCheckResourceAttr("netapp-ontap_rest_api_resource.example", "record.name", "acc_test_qtree"),
+					resource.TestCheckResourceAttr("netapp-ontap_rest_api_resource.example", "record.security_style", "unix"),
+				),
+			},
+			// update the security style
+			{
+				Config: testAccRestAPIResourceConfig(`jsonencode({name = "acc_test_qtree", svm = {name = "carchi-test"}, volume = {name = "carchi_test_root"}})`, "mixed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_rest_api_resource.example", "record.security_style", "mixed"),
+				),
+			},
+		},
+	})
+}
+
+func TestRestAPIResource_ModifyPlan(t *testing.T) {
+	tests := []struct {
+		name           string
+		planDeletePath string
+		wantUnknownID  bool
+	}{
+		{name: "test_same_delete_path", planDeletePath: "storage/qtrees/{volume.uuid}/{id}", wantUnknownID: false},
+		{name: "test_new_delete_path", planDeletePath: "storage/qtrees/{volume.uuid}/{name}", wantUnknownID: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := NewRestAPIResource().(*RestAPIResource)
+			schemaResp := tfresource.SchemaResponse{}
+			r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			// id is copied from the state by UseStateForUnknown
+			diags := state.SetAttribute(ctx, path.Root("delete_path"), "storage/qtrees/{volume.uuid}/{id}")
+			diags.Append(state.SetAttribute(ctx, path.Root("id"), "storage/qtrees/1234/1")...)
+			diags.Append(plan.SetAttribute(ctx, path.Root("delete_path"), tt.planDeletePath)...)
+			diags.Append(plan.SetAttribute(ctx, path.Root("id"), "storage/qtrees/1234/1")...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error setting plan and state: %v", diags)
+			}
+			resp := tfresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, tfresource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("RestAPIResource.ModifyPlan() unexpected error: %v", resp.Diagnostics)
+			}
+			var data RestAPIResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error reading plan: %v", resp.Diagnostics)
+			}
+			if data.ID.IsUnknown() != tt.wantUnknownID {
+				t.Errorf("RestAPIResource.ModifyPlan() id = %v, wantUnknownID %v", data.ID, tt.wantUnknownID)
+			}
+		})
+	}
+}
+
+func testAccRestAPIResourceConfig(createBody string, securityStyle string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_rest_api_resource" "example" {
+  cx_profile_name = "cluster4"
+  api = "storage/qtrees"
+  create_body = %s
+  patch_body = jsonencode({security_style = "%s"})
+  key_query = {
+    name = "acc_test_qtree"
+    "svm.name" = "carchi-test"
+    "volume.name" = "carchi_test_root"
+  }
+  delete_path = "storage/qtrees/{volume.uuid}/{id}"
+  drift_fields = ["name", "security_style"]
+}
+`, host, admin, password, createBody, securityStyle)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SanIgroupDataSource{}
+
+// NewSanIgroupDataSource is a helper function to simplify the provider implementation.
+func NewSanIgroupDataSource() datasource.DataSource {
+	return &SanIgroupDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "san_igroup_data_source",
+		},
+	}
+}
+
+// SanIgroupDataSource defines the data source implementation.
+type SanIgroupDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SanIgroupDataSourceModel describes the data source data model.
+type SanIgroupDataSourceModel struct {
+	CxProfileName types.String                        `tfsdk:"cx_profile_name"`
+	SVMName       types.String                        `tfsdk:"svm_name"`
+	Name          types.String                        `tfsdk:"name"`
+	OsType        types.String                        `tfsdk:"os_type"`
+	Protocol      types.String                        `tfsdk:"protocol"`
+	Comment       types.String                        `tfsdk:"comment"`
+	Initiators    []SanIgroupInitiatorDataSourceModel `tfsdk:"initiators"`
+	Igroups       []types.String                      `tfsdk:"igroups"`
+	ID            types.String                        `tfsdk:"id"`
+}
+
+// SanIgroupInitiatorDataSourceModel describes an initiator of the igroup
+type SanIgroupInitiatorDataSourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+// Metadata returns the data source type name.
+func (d *SanIgroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *SanIgroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SanIgroup data source",
+
+		Attributes: sanIgroupDataSourceAttributes(true),
+	}
+}
+
+// sanIgroupDataSourceAttributes returns the attributes of an igroup.
+// cx_profile_name, svm_name and name are required to read a single igroup, and computed in a list of igroups.
+func sanIgroupDataSourceAttributes(single bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cx_profile_name": schema.StringAttribute{
+			MarkdownDescription: "Connection profile name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"svm_name": schema.StringAttribute{
+			MarkdownDescription: "SanIgroup svm name",
+			Required:            single,
+			Computed:            !single,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the igroup",
+			Required:            single,
+			Computed:            !single,
+		},
+		"os_type": schema.StringAttribute{
+			MarkdownDescription: "Operating system of the initiators",
+			Computed:            true,
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol of the initiators, iscsi, fcp or mixed",
+			Computed:            true,
+		},
+		"comment": schema.StringAttribute{
+			MarkdownDescription: "Text comment about the igroup",
+			Computed:            true,
+		},
+		"initiators": schema.ListNestedAttribute{
+			MarkdownDescription: "Initiators of the igroup, the initiators of nested igroups are not included",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "iSCSI name or FC WWPN of the initiator",
+						Computed:            true,
+					},
+					"comment": schema.StringAttribute{
+						MarkdownDescription: "Text comment about the initiator",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"igroups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Names of the nested igroups",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the igroup",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SanIgroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SanIgroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SanIgroupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSanIgroupByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanIgroupByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No igroup found", fmt.Sprintf("igroup %s not found in svm %s.", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+	data = sanIgroupDataSourceModel(data.CxProfileName, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sanIgroupDataSourceModel converts an igroup returned by ONTAP, initiators inherited from nested igroups are ignored
+func sanIgroupDataSourceModel(cxProfileName types.String, record *interfaces.SanIgroupGetDataModelONTAP) SanIgroupDataSourceModel {
+	data := SanIgroupDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       types.StringValue(record.SVM.Name),
+		Name:          types.StringValue(record.Name),
+		OsType:        types.StringValue(record.OsType),
+		Protocol:      types.StringValue(record.Protocol),
+		Comment:       types.StringValue(record.Comment),
+		ID:            types.StringValue(record.UUID),
+	}
+	for _, initiator := range record.Initiators {
+		if initiator.Igroup.Name != "" {
+			continue
+		}
+		data.Initiators = append(data.Initiators, SanIgroupInitiatorDataSourceModel{
+			Name:    types.StringValue(initiator.Name),
+			Comment: types.StringValue(initiator.Comment),
+		})
+	}
+	for _, igroup := range record.Igroups {
+		data.Igroups = append(data.Igroups, types.StringValue(igroup.Name))
+	}
+	return data
+}
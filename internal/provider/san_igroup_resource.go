@@ -0,0 +1,499 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SanIgroupResource{}
+var _ resource.ResourceWithImportState = &SanIgroupResource{}
+
+// NewSanIgroupResource is a helper function to simplify the provider implementation.
+func NewSanIgroupResource() resource.Resource {
+	return &SanIgroupResource{
+		config: resourceOrDataSourceConfig{
+			name: "san_igroup_resource",
+		},
+	}
+}
+
+// SanIgroupResource defines the resource implementation.
+type SanIgroupResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SanIgroupResourceModel describes the resource data model.
+type SanIgroupResourceModel struct {
+	CxProfileName types.String                      `tfsdk:"cx_profile_name"`
+	SVMName       types.String                      `tfsdk:"svm_name"`
+	Name          types.String                      `tfsdk:"name"`
+	OsType        types.String                      `tfsdk:"os_type"`
+	Protocol      types.String                      `tfsdk:"protocol"`
+	Comment       types.String                      `tfsdk:"comment"`
+	Initiators    []SanIgroupInitiatorResourceModel `tfsdk:"initiators"`
+	Igroups       []types.String                    `tfsdk:"igroups"`
+	ID            types.String                      `tfsdk:"id"`
+	Timeouts      timeouts.Value                    `tfsdk:"timeouts"`
+}
+
+// SanIgroupInitiatorResourceModel describes an initiator of the igroup
+type SanIgroupInitiatorResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+// Metadata returns the resource type name.
+func (r *SanIgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SanIgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SanIgroup resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SanIgroup svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the igroup",
+				Required:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the initiators",
+				Required:            true,
+				Validators: []validator.String{stringvalidator.OneOf("aix", "hpux", "hyper_v", "linux", "netware", "openvms", "solaris",
+					"vmware", "windows", "xen")},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol of the initiators, iscsi, fcp or mixed",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("mixed"),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf("fcp", "iscsi", "mixed")},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Text comment about the igroup",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"initiators": schema.SetNestedAttribute{
+				MarkdownDescription: "Initiators of the igroup, iSCSI names or FC WWPNs. Initiators are added and removed in place",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "iSCSI name or FC WWPN of the initiator",
+							Required:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Text comment about the initiator",
+							Optional:            true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("igroups"),
+					}...),
+				},
+			},
+			"igroups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the nested igroups, whose initiators are included in this igroup",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the igroup",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SanIgroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SanIgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SanIgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSanIgroupByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanIgroupByName
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "igroup", data.Name.ValueString())
+		return
+	}
+	setSanIgroupResourceModel(data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *SanIgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SanIgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SanIgroupResourceBodyDataModelONTAP{
+		Name:     data.Name.ValueString(),
+		SVM:      &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()},
+		OsType:   data.OsType.ValueString(),
+		Protocol: data.Protocol.ValueString(),
+	}
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	for _, initiator := range data.Initiators {
+		record := map[string]interface{}{"name": initiator.Name.ValueString()}
+		if initiator.Comment.ValueString() != "" {
+			record["comment"] = initiator.Comment.ValueString()
+		}
+		body.Initiators = append(body.Initiators, record)
+	}
+	for _, igroup := range data.Igroups {
+		body.Igroups = append(body.Igroups, map[string]interface{}{"name": igroup.ValueString()})
+	}
+	_, err = interfaces.CreateSanIgroup(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetSanIgroupByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanIgroupByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No igroup found", fmt.Sprintf("igroup %s not found after create.", data.Name.ValueString()))
+		return
+	}
+	setSanIgroupResourceModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Initiators and nested igroups are removed before new ones are added, so that an igroup can switch from one to the other.
+func (r *SanIgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *SanIgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read state file data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	uuid := state.ID.ValueString()
+
+	var body interfaces.SanIgroupResourceBodyDataModelONTAP
+	modified := false
+	if !data.Name.Equal(state.Name) {
+		body.Name = data.Name.ValueString()
+		modified = true
+	}
+	if !data.OsType.Equal(state.OsType) {
+		body.OsType = data.OsType.ValueString()
+		modified = true
+	}
+	if !data.Comment.IsUnknown() && !data.Comment.Equal(state.Comment) {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+		modified = true
+	}
+	if modified {
+		err = interfaces.UpdateSanIgroup(errorHandler, *client, body, uuid)
+		if err != nil {
+			return
+		}
+	}
+
+	plannedInitiators := sanIgroupInitiatorsByName(data.Initiators)
+	currentInitiators := sanIgroupInitiatorsByName(state.Initiators)
+	for name, initiator := range currentInitiators {
+		if _, ok := plannedInitiators[name]; !ok {
+			err = interfaces.RemoveSanIgroupInitiator(errorHandler, *client, uuid, initiator.Name.ValueString())
+			if err != nil {
+				return
+			}
+		}
+	}
+	plannedIgroups := sanIgroupNamesByName(data.Igroups)
+	currentIgroups := sanIgroupNamesByName(state.Igroups)
+	if len(currentIgroups) > 0 {
+		// nested igroups are removed using their UUID
+		restInfo, err := interfaces.GetSanIgroupByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+		if err != nil {
+			// error reporting done inside GetSanIgroupByName
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("No igroup found", fmt.Sprintf("igroup %s not found.", data.Name.ValueString()))
+			return
+		}
+		for _, igroup := range restInfo.Igroups {
+			if _, ok := plannedIgroups[strings.ToLower(igroup.Name)]; !ok {
+				err = interfaces.RemoveSanIgroupNestedIgroup(errorHandler, *client, uuid, igroup.UUID)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	for name, initiator := range plannedInitiators {
+		current, ok := currentInitiators[name]
+		if !ok {
+			err = interfaces.AddSanIgroupInitiator(errorHandler, *client, uuid, initiator.Name.ValueString(), initiator.Comment.ValueString())
+		} else if initiator.Comment.ValueString() != current.Comment.ValueString() {
+			err = interfaces.UpdateSanIgroupInitiator(errorHandler, *client, uuid, initiator.Name.ValueString(), initiator.Comment.ValueString())
+		}
+		if err != nil {
+			return
+		}
+	}
+	for name, igroup := range plannedIgroups {
+		if _, ok := currentIgroups[name]; !ok {
+			err = interfaces.AddSanIgroupNestedIgroup(errorHandler, *client, uuid, igroup.ValueString())
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	restInfo, err := interfaces.GetSanIgroupByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanIgroupByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No igroup found", fmt.Sprintf("igroup %s not found after update.", data.Name.ValueString()))
+		return
+	}
+	setSanIgroupResourceModel(data, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// ONTAP does not delete an igroup that is mapped to a LUN, or nested in another igroup.
+func (r *SanIgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SanIgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "igroup UUID is null")
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	err = interfaces.DeleteSanIgroup(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SanIgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// sanIgroupInitiatorsByName indexes initiators by name, ONTAP ignores the case of the names
+func sanIgroupInitiatorsByName(initiators []SanIgroupInitiatorResourceModel) map[string]SanIgroupInitiatorResourceModel {
+	initiatorsByName := make(map[string]SanIgroupInitiatorResourceModel, len(initiators))
+	for _, initiator := range initiators {
+		initiatorsByName[strings.ToLower(initiator.Name.ValueString())] = initiator
+	}
+	return initiatorsByName
+}
+
+// sanIgroupNamesByName indexes nested igroups by name
+func sanIgroupNamesByName(igroups []types.String) map[string]types.String {
+	igroupsByName := make(map[string]types.String, len(igroups))
+	for _, igroup := range igroups {
+		igroupsByName[strings.ToLower(igroup.ValueString())] = igroup
+	}
+	return igroupsByName
+}
+
+// setSanIgroupResourceModel updates data with the values returned by ONTAP.
+// The names of initiators and nested igroups are kept as configured when they only differ by case,
+// and an empty comment is kept null when the initiator comment is not set.
+// Initiators inherited from nested igroups are ignored.
+func setSanIgroupResourceModel(data *SanIgroupResourceModel, restInfo *interfaces.SanIgroupGetDataModelONTAP) {
+	data.ID = types.StringValue(restInfo.UUID)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Name = types.StringValue(restInfo.Name)
+	data.OsType = types.StringValue(restInfo.OsType)
+	data.Protocol = types.StringValue(restInfo.Protocol)
+	data.Comment = types.StringValue(restInfo.Comment)
+
+	currentInitiators := sanIgroupInitiatorsByName(data.Initiators)
+	initiators := make([]SanIgroupInitiatorResourceModel, 0, len(restInfo.Initiators))
+	for _, record := range restInfo.Initiators {
+		if record.Igroup.Name != "" {
+			continue
+		}
+		initiator := SanIgroupInitiatorResourceModel{
+			Name:    types.StringValue(record.Name),
+			Comment: types.StringValue(record.Comment),
+		}
+		if current, ok := currentInitiators[strings.ToLower(record.Name)]; ok {
+			initiator.Name = current.Name
+			if record.Comment == "" && current.Comment.IsNull() {
+				initiator.Comment = types.StringNull()
+			}
+		} else if record.Comment == "" {
+			initiator.Comment = types.StringNull()
+		}
+		initiators = append(initiators, initiator)
+	}
+	if len(initiators) > 0 || data.Initiators != nil {
+		data.Initiators = initiators
+	}
+
+	igroups := make([]string, 0, len(restInfo.Igroups))
+	for _, igroup := range restInfo.Igroups {
+		igroups = append(igroups, igroup.Name)
+	}
+	if !equalFoldStringSets(data.Igroups, igroups) {
+		data.Igroups = flattenTypesStringList(igroups)
+	}
+}
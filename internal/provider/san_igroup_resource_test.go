@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSanIgroupResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccSanIgroupResourceConfig("non-existant", "linux", `"iqn.1994-05.com.redhat:host1"`),
+				ExpectError: regexp.MustCompile("error creating igroup"),
+			},
+			// Create and read
+			{
+				Config: testAccSanIgroupResourceConfig("carchi-test", "linux", `"iqn.1994-05.com.redhat:host1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_igroup_resource.example", "name", "tfigroup"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_igroup_resource.example", "protocol", "iscsi"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_igroup_resource.example", "initiators.#", "1"),
+				),
+			},
+			// add an initiator and change os_type in place
+			{
+				Config: testAccSanIgroupResourceConfig("carchi-test", "vmware", `"iqn.1994-05.com.redhat:host1", "iqn.1994-05.com.redhat:host2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_igroup_resource.example", "os_type", "vmware"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_igroup_resource.example", "initiators.#", "2"),
+				),
+			},
+			// remove an initiator in place
+			{
+				Config: testAccSanIgroupResourceConfig("carchi-test", "vmware", `"iqn.1994-05.com.redhat:host2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_igroup_resource.example", "initiators.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("netapp-ontap_san_igroup_resource.example", "initiators.*", map[string]string{"name": "iqn.1994-05.com.redhat:host2"}),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:      "netapp-ontap_san_igroup_resource.example",
+				ImportState:       true,
+				ImportStateId:     "carchi-test,tfigroup,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSanIgroupResourceConfig(svmName, osType, initiators string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_san_igroup_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "tfigroup"
+  os_type = "%s"
+  protocol = "iscsi"
+  initiators = [for name in [%s] : { name = name }]
+}`, host, admin, password, svmName, osType, initiators)
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SanLunMapResource{}
+var _ resource.ResourceWithImportState = &SanLunMapResource{}
+
+// NewSanLunMapResource is a helper function to simplify the provider implementation.
+func NewSanLunMapResource() resource.Resource {
+	return &SanLunMapResource{
+		config: resourceOrDataSourceConfig{
+			name: "san_lun_map_resource",
+		},
+	}
+}
+
+// SanLunMapResource defines the resource implementation.
+type SanLunMapResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SanLunMapResourceModel describes the resource data model.
+type SanLunMapResourceModel struct {
+	CxProfileName     types.String   `tfsdk:"cx_profile_name"`
+	SVMName           types.String   `tfsdk:"svm_name"`
+	LunName           types.String   `tfsdk:"lun_name"`
+	IgroupName        types.String   `tfsdk:"igroup_name"`
+	LogicalUnitNumber types.Int64    `tfsdk:"logical_unit_number"`
+	ID                types.String   `tfsdk:"id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *SanLunMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SanLunMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SanLunMap resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SanLunMap svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"lun_name": schema.StringAttribute{
+				MarkdownDescription: "Path of the LUN, eg /vol/vol1/lun1",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"igroup_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the igroup the LUN is mapped to",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"logical_unit_number": schema.Int64Attribute{
+				MarkdownDescription: "LUN ID seen by the hosts of the igroup, allocated by ONTAP when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplaceIfConfigured()},
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SanLunMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SanLunMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SanLunMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSanLunMap(errorHandler, *client, data.SVMName.ValueString(), data.LunName.ValueString(), data.IgroupName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanLunMap
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "LUN map", fmt.Sprintf("%s to %s", data.LunName.ValueString(), data.IgroupName.ValueString()))
+		return
+	}
+	data.LogicalUnitNumber = types.Int64Value(restInfo.LogicalUnitNumber)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s_%s", data.CxProfileName.ValueString(), data.SVMName.ValueString(), data.LunName.ValueString(), data.IgroupName.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *SanLunMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SanLunMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.SanLunMapResourceBodyDataModelONTAP{
+		SVM:    interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()},
+		Lun:    interfaces.SanLunMapReference{Name: data.LunName.ValueString()},
+		Igroup: interfaces.SanLunMapReference{Name: data.IgroupName.ValueString()},
+	}
+	if !data.LogicalUnitNumber.IsNull() && !data.LogicalUnitNumber.IsUnknown() {
+		logicalUnitNumber := data.LogicalUnitNumber.ValueInt64()
+		body.LogicalUnitNumber = &logicalUnitNumber
+	}
+	err = interfaces.CreateSanLunMap(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the LUN ID allocated by ONTAP
+	restInfo, err := interfaces.GetSanLunMap(errorHandler, *client, data.SVMName.ValueString(), data.LunName.ValueString(), data.IgroupName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanLunMap
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No LUN map found", fmt.Sprintf("LUN map %s to %s not found after create.", data.LunName.ValueString(), data.IgroupName.ValueString()))
+		return
+	}
+	data.LogicalUnitNumber = types.Int64Value(restInfo.LogicalUnitNumber)
+	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s_%s", data.CxProfileName.ValueString(), data.SVMName.ValueString(), data.LunName.ValueString(), data.IgroupName.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not supported, all the attributes require a replacement.
+func (r *SanLunMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SanLunMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only cx_profile_name and timeouts can change without a replacement
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SanLunMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SanLunMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// the map is deleted using the UUIDs of the LUN and of the igroup
+	restInfo, err := interfaces.GetSanLunMap(errorHandler, *client, data.SVMName.ValueString(), data.LunName.ValueString(), data.IgroupName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanLunMap
+		return
+	}
+	if restInfo == nil {
+		tflog.Debug(ctx, fmt.Sprintf("LUN map %s to %s already deleted", data.LunName.ValueString(), data.IgroupName.ValueString()))
+		return
+	}
+	err = interfaces.DeleteSanLunMap(errorHandler, *client, restInfo.Lun.UUID, restInfo.Igroup.UUID)
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SanLunMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,lun_name,igroup_name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("lun_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("igroup_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+}
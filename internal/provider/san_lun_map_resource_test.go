@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSanLunMapResource_logicalUnitNumberPlanModifiers(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          types.Int64
+		plan            types.Int64
+		want            types.Int64
+		requiresReplace bool
+	}{
+		// the LUN ID allocated by ONTAP is kept when not set in the configuration
+		{name: "test_not_configured", config: types.Int64Null(), plan: types.Int64Unknown(), want: types.Int64Value(1), requiresReplace: false},
+		{name: "test_same_value", config: types.Int64Value(1), plan: types.Int64Value(1), want: types.Int64Value(1), requiresReplace: false},
+		{name: "test_new_value", config: types.Int64Value(2), plan: types.Int64Value(2), want: types.Int64Value(2), requiresReplace: true},
+	}
+	ctx := context.Background()
+	schemaResp := tfresource.SchemaResponse{}
+	NewSanLunMapResource().Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+	modifiers := schemaResp.Schema.Attributes["logical_unit_number"].(schema.Int64Attribute).PlanModifiers
+	// RequiresReplace is only reported for an existing resource that is not destroyed
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.SetAttribute(ctx, path.Root("logical_unit_number"), int64(1)); diags.HasError() {
+		t.Fatalf("unexpected error setting state: %v", diags)
+	}
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the modifiers are applied in order, each one using the plan value from the previous one
+			resp := planmodifier.Int64Response{PlanValue: tt.plan}
+			for _, modifier := range modifiers {
+				req := planmodifier.Int64Request{ConfigValue: tt.config, PlanValue: resp.PlanValue, StateValue: types.Int64Value(1), State: state, Plan: plan}
+				modifier.PlanModifyInt64(ctx, req, &resp)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("logical_unit_number plan = %v, want %v", resp.PlanValue, tt.want)
+			}
+			if resp.RequiresReplace != tt.requiresReplace {
+				t.Errorf("logical_unit_number RequiresReplace = %v, want %v", resp.RequiresReplace, tt.requiresReplace)
+			}
+		})
+	}
+}
+
+func TestAccSanLunMapResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccSanLunMapResourceConfig("non-existant", 1),
+				ExpectError: regexp.MustCompile("error creating LUN map"),
+			},
+			// Create and read
+			{
+				Config: testAccSanLunMapResourceConfig("tfmapigroup", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_map_resource.example", "lun_name", "/vol/lun_vol/tfmaplun"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_map_resource.example", "igroup_name", "tfmapigroup"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_map_resource.example", "logical_unit_number", "1"),
+				),
+			},
+			// a new LUN ID replaces the map
+			{
+				Config: testAccSanLunMapResourceConfig("tfmapigroup", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_map_resource.example", "logical_unit_number", "2"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:      "netapp-ontap_san_lun_map_resource.example",
+				ImportState:       true,
+				ImportStateId:     "carchi-test,/vol/lun_vol/tfmaplun,tfmapigroup,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSanLunMapResourceConfig(igroupName string, logicalUnitNumber int) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_san_lun_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "/vol/lun_vol/tfmaplun"
+  os_type = "linux"
+  size = 1
+  size_unit = "gb"
+}
+
+resource "netapp-ontap_san_igroup_resource" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "tfmapigroup"
+  os_type = "linux"
+  protocol = "iscsi"
+}
+
+resource "netapp-ontap_san_lun_map_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  lun_name = netapp-ontap_san_lun_resource.example.name
+  igroup_name = "%s"
+  logical_unit_number = %d
+  depends_on = [netapp-ontap_san_igroup_resource.example]
+}`, host, admin, password, igroupName, logicalUnitNumber)
+}
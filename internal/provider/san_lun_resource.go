@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SanLunResource{}
+var _ resource.ResourceWithImportState = &SanLunResource{}
+var _ resource.ResourceWithModifyPlan = &SanLunResource{}
+
+// NewSanLunResource is a helper function to simplify the provider implementation.
+func NewSanLunResource() resource.Resource {
+	return &SanLunResource{
+		config: resourceOrDataSourceConfig{
+			name: "san_lun_resource",
+		},
+	}
+}
+
+// SanLunResource defines the resource implementation.
+type SanLunResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SanLunResourceModel describes the resource data model.
+type SanLunResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	OsType        types.String   `tfsdk:"os_type"`
+	Size          types.Int64    `tfsdk:"size"`
+	SizeUnit      types.String   `tfsdk:"size_unit"`
+	SpaceReserve  types.Bool     `tfsdk:"space_reserve"`
+	QosPolicyName types.String   `tfsdk:"qos_policy_name"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Comment       types.String   `tfsdk:"comment"`
+	SerialNumber  types.String   `tfsdk:"serial_number"`
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *SanLunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SanLunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SanLun resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SanLun svm name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Path of the LUN, /vol/<volume>/<lun> or /vol/<volume>/<qtree>/<lun>",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^/vol/[^/]+/([^/]+/)?[^/]+$`), "must be /vol/<volume>/<lun> or /vol/<volume>/<qtree>/<lun>")},
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the host, used to align the LUN",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{stringvalidator.OneOf("aix", "hpux", "hyper_v", "linux", "netware", "openvms", "solaris", "solaris_efi",
+					"vmware", "windows", "windows_2008", "windows_gpt", "xen")},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the LUN. The LUN is grown in place, it cannot be shrunk",
+				Required:            true,
+			},
+			"size_unit": schema.StringAttribute{
+				MarkdownDescription: "The unit used to interpret the size parameter",
+				Required:            true,
+			},
+			"space_reserve": schema.BoolAttribute{
+				MarkdownDescription: "Whether the space of the LUN is reserved in the volume",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"qos_policy_name": schema.StringAttribute{
+				MarkdownDescription: "QoS policy group of the LUN",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the LUN is online",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Text comment about the LUN",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Serial number of the LUN, reported to the hosts",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the LUN",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SanLunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ModifyPlan validates size_unit, and reports a smaller size before any change is made, as a LUN cannot be shrunk.
+func (r *SanLunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *SanLunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// plan is null when the resource is destroyed
+	if plan == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if plan.Size.IsUnknown() || plan.SizeUnit.IsUnknown() {
+		return
+	}
+	if _, ok := interfaces.POW2BYTEMAP[plan.SizeUnit.ValueString()]; !ok {
+		errorHandler.MakeAndReportError("error creating LUN", fmt.Sprintf("invalid input for size_unit: %s, required one of: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb", plan.SizeUnit.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// state is null when the resource is created
+	if state == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	if sanLunSizeBytes(plan) < sanLunSizeBytes(state) {
+		errorHandler.MakeAndReportError("error updating LUN", fmt.Sprintf("LUN %s cannot be shrunk from %d %s to %d %s", state.Name.ValueString(),
+			state.Size.ValueInt64(), state.SizeUnit.ValueString(), plan.Size.ValueInt64(), plan.SizeUnit.ValueString()))
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SanLunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SanLunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSanLunByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanLunByName
+		return
+	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "LUN", data.Name.ValueString())
+		return
+	}
+	setSanLunResourceModel(data, restInfo)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *SanLunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SanLunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := sanLunBody(data)
+	body.SVM = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	body.Name = data.Name.ValueString()
+	body.OsType = data.OsType.ValueString()
+	body.Space.Size = sanLunSizeBytes(data)
+	_, err = interfaces.CreateSanLun(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the values set by ONTAP, eg the serial number
+	restInfo, err := interfaces.GetSanLunByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanLunByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No LUN found", fmt.Sprintf("LUN %s not found after create.", data.Name.ValueString()))
+		return
+	}
+	setSanLunResourceModel(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SanLunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *SanLunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read state file data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := sanLunBody(data)
+	// a change of size_unit alone does not resize the LUN
+	if size := sanLunSizeBytes(data); size != sanLunSizeBytes(state) {
+		body.Space.Size = size
+	}
+	if body.Space.Size == 0 && body.Space.Guarantee == nil {
+		body.Space = nil
+	}
+	err = interfaces.UpdateSanLun(errorHandler, *client, body, state.ID.ValueString())
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetSanLunByName(errorHandler, *client, data.SVMName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetSanLunByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No LUN found", fmt.Sprintf("LUN %s not found after update.", data.Name.ValueString()))
+		return
+	}
+	setSanLunResourceModel(data, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// ONTAP does not delete a LUN that is mapped to an igroup.
+func (r *SanLunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SanLunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "LUN UUID is null")
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	err = interfaces.DeleteSanLun(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SanLunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportID(req.ID, "svm_name,name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// sanLunBody returns the settings shared by POST and PATCH.  Unknown values are left for ONTAP to set.
+func sanLunBody(data *SanLunResourceModel) interfaces.SanLunResourceBodyDataModelONTAP {
+	body := interfaces.SanLunResourceBodyDataModelONTAP{
+		Space:   &interfaces.SanLunSpaceBody{},
+		Enabled: knownBool(data.Enabled),
+	}
+	if requested := knownBool(data.SpaceReserve); requested != nil {
+		body.Space.Guarantee = &interfaces.SanLunSpaceReserveBody{Requested: requested}
+	}
+	if !data.QosPolicyName.IsNull() && !data.QosPolicyName.IsUnknown() {
+		body.QosPolicy = &interfaces.SanLunQosPolicy{Name: data.QosPolicyName.ValueString()}
+	}
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
+		comment := data.Comment.ValueString()
+		body.Comment = &comment
+	}
+	return body
+}
+
+// sanLunSizeBytes returns the size of the LUN in bytes, size_unit is validated in ModifyPlan
+func sanLunSizeBytes(data *SanLunResourceModel) int64 {
+	return data.Size.ValueInt64() * int64(interfaces.POW2BYTEMAP[data.SizeUnit.ValueString()])
+}
+
+// setSanLunResourceModel updates data with the values returned by ONTAP.
+// The size is converted to size_unit, or to the largest unit after an import.
+func setSanLunResourceModel(data *SanLunResourceModel, restInfo *interfaces.SanLunGetDataModelONTAP) {
+	sizeUnit := data.SizeUnit.ValueString()
+	if _, ok := interfaces.POW2BYTEMAP[sizeUnit]; !ok {
+		_, sizeUnit = interfaces.ByteFormat(restInfo.Space.Size)
+	}
+	data.ID = types.StringValue(restInfo.UUID)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Name = types.StringValue(restInfo.Name)
+	data.OsType = types.StringValue(restInfo.OsType)
+	data.Size = types.Int64Value(restInfo.Space.Size / int64(interfaces.POW2BYTEMAP[sizeUnit]))
+	data.SizeUnit = types.StringValue(sizeUnit)
+	data.SpaceReserve = types.BoolValue(restInfo.Space.Guarantee.Requested)
+	data.QosPolicyName = types.StringValue(restInfo.QosPolicy.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.SerialNumber = types.StringValue(restInfo.SerialNumber)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSanLunResource(t *testing.T) {
+	testAccReplay(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccSanLunResourceConfig("non-existant", 1, "gb", true),
+				ExpectError: regexp.MustCompile("error creating LUN"),
+			},
+			// Test invalid size_unit
+			{
+				Config:      testAccSanLunResourceConfig("carchi-test", 1, "gib", true),
+				ExpectError: regexp.MustCompile("invalid input for size_unit"),
+			},
+			// Create and read
+			{
+				Config: testAccSanLunResourceConfig("carchi-test", 1, "gb", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "name", "/vol/lun_vol/tflun"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "os_type", "linux"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "size", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_san_lun_resource.example", "serial_number"),
+				),
+			},
+			// grow in place and take offline
+			{
+				Config: testAccSanLunResourceConfig("carchi-test", 2048, "mb", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "size", "2048"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "size_unit", "mb"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_lun_resource.example", "enabled", "false"),
+				),
+			},
+			// Test shrinking is rejected
+			{
+				Config:      testAccSanLunResourceConfig("carchi-test", 1, "gb", false),
+				ExpectError: regexp.MustCompile("cannot be shrunk"),
+			},
+			// Test importing a resource
+			{
+				ResourceName:            "netapp-ontap_san_lun_resource.example",
+				ImportState:             true,
+				ImportStateId:           "carchi-test,/vol/lun_vol/tflun,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"size", "size_unit"},
+			},
+		},
+	})
+}
+
+func testAccSanLunResourceConfig(svmName string, size int, sizeUnit string, enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_san_lun_resource" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "/vol/lun_vol/tflun"
+  os_type = "linux"
+  size = %d
+  size_unit = "%s"
+  space_reserve = false
+  enabled = %t
+}`, host, admin, password, svmName, size, sizeUnit, enabled)
+}
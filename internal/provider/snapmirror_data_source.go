@@ -224,7 +224,7 @@ func (d *SnapmirrorDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
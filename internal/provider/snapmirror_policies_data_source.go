@@ -185,7 +185,7 @@ func (d *SnapmirrorPoliciesDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -188,7 +188,7 @@ func (d *SnapmirrorPolicyDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
@@ -26,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &SnapmirrorPolicyResource{}
 var _ resource.ResourceWithImportState = &SnapmirrorPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &SnapmirrorPolicyResource{}
 
 // NewSnapmirrorPolicyResource is a helper function to simplify the provider implementation.
 func NewSnapmirrorPolicyResource() resource.Resource {
@@ -236,6 +237,32 @@ func (r *SnapmirrorPolicyResource) Configure(ctx context.Context, req resource.C
 	r.config.providerConfig = config
 }
 
+// ModifyPlan reports attributes that are not supported by the ONTAP version of the cluster, before any change is made.
+func (r *SnapmirrorPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config *SnapmirrorPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	// config is null when the resource is destroyed
+	if config == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	requirements := map[string]string{}
+	if config.SyncType.ValueString() == "automated_failover" {
+		requirements["sync_type automated_failover"] = "9.8.0"
+	}
+	if !config.CopyAllSourceSnapshots.IsNull() {
+		requirements["attribute copy_all_source_snapshots"] = "9.10.1"
+	}
+	if !config.CopyLatestSourceSnapshot.IsNull() {
+		requirements["attribute copy_latest_source_snapshot"] = "9.11.1"
+	}
+	if !config.CreateSnapshotOnSource.IsNull() {
+		requirements["attribute create_snapshot_on_source"] = "9.11.1"
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	checkONTAPVersion(errorHandler, r.config, config.CxProfileName, requirements)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *SnapmirrorPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data SnapmirrorPolicyResourceModel
@@ -260,6 +287,10 @@ func (r *SnapmirrorPolicyResource) Read(ctx context.Context, req resource.ReadRe
 		// error reporting done inside GETSnapmirrorPolicy
 		return
 	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "snapmirror policy", data.Name.ValueString())
+		return
+	}
 
 	if restInfo.TransferSchedule.Name != "" {
 		data.TransferScheduleName = types.StringValue(restInfo.TransferSchedule.Name)
@@ -557,6 +588,10 @@ func (r *SnapmirrorPolicyResource) Update(ctx context.Context, req resource.Upda
 		// error reporting done inside GETSnapmirrorPolicy
 		return
 	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No snapmirror policy found", fmt.Sprintf("snapmirror policy %s not found.", plan.Name.ValueString()))
+		return
+	}
 
 	if restInfo.Retention == nil {
 		plan.Retention = nil
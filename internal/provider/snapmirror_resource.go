@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -10,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -47,6 +50,7 @@ type SnapmirrorResourceModel struct {
 	Healthy             types.Bool         `tfsdk:"healthy"`
 	State               types.String       `tfsdk:"state"`
 	ID                  types.String       `tfsdk:"id"`
+	Timeouts            timeouts.Value     `tfsdk:"timeouts"`
 }
 
 // EndPoint describes source/destination endpoint data model.
@@ -152,6 +156,14 @@ func (r *SnapmirrorResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -182,6 +194,14 @@ func (r *SnapmirrorResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	// we need to defer setting the client until we can read the connection profile name
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
@@ -195,6 +215,10 @@ func (r *SnapmirrorResource) Read(ctx context.Context, req resource.ReadRequest,
 		// error reporting done inside GetSnapmirrorByID
 		return
 	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "snapmirror relationship", data.ID.ValueString())
+		return
+	}
 
 	data.ID = types.StringValue(restInfo.UUID)
 	data.Healthy = types.BoolValue(restInfo.Healthy)
@@ -240,7 +264,12 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -257,6 +286,10 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 		// error reporting done inside GetSnapmirror
 		return
 	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No snapmirror relationship found", fmt.Sprintf("snapmirror relationship %s not found.", resource.UUID))
+		return
+	}
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snapmirror info: %#v", restInfo))
 	data.Healthy = types.BoolValue(restInfo.Healthy)
 	data.State = types.StringValue(restInfo.State)
@@ -278,6 +311,10 @@ func (r *SnapmirrorResource) Create(ctx context.Context, req resource.CreateRequ
 		// error reporting done inside GetSnapmirror
 		return
 	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No snapmirror relationship found", fmt.Sprintf("snapmirror relationship %s not found.", resource.UUID))
+		return
+	}
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snapmirror info: %#v", restInfo))
 	// Update the computed parameters
 	data.Healthy = types.BoolValue(restInfo.Healthy)
@@ -302,6 +339,15 @@ func (r *SnapmirrorResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Changing the timeouts block only affects the provider, there is nothing to update on ONTAP
+	if plan.CxProfileName.Equal(state.CxProfileName) && reflect.DeepEqual(plan.SourceEndPoint, state.SourceEndPoint) &&
+		reflect.DeepEqual(plan.DestinationEndPoint, state.DestinationEndPoint) && reflect.DeepEqual(plan.CreateDestination, state.CreateDestination) &&
+		plan.Initialize.Equal(state.Initialize) {
+		plan.Healthy = state.Healthy
+		plan.State = state.State
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	// License updates are not supported
 	err := errorHandler.MakeAndReportError("Update not supported for snapmirror", "Update not supported for snapmirror")
@@ -324,7 +370,12 @@ func (r *SnapmirrorResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -343,6 +394,41 @@ func (r *SnapmirrorResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
+// The ID is either destination_path,cx_profile_name or the relationship UUID.
 func (r *SnapmirrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !strings.Contains(req.ID, ",") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	idParts, ok := splitImportID(req.ID, "destination_path,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, types.StringValue(idParts[1]))
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetClusterInfo
+		return
+	}
+	restInfo, err := interfaces.GetSnapmirrorByDestinationPath(errorHandler, *client, idParts[0], cluster.Version)
+	if err != nil {
+		// error reporting done inside GetSnapmirrorByDestinationPath
+		return
+	}
+	source := &EndPoint{Path: types.StringValue(restInfo.Source.Path)}
+	// the source cluster is only set for a relationship between two clusters
+	if restInfo.Source.Cluster.Name != "" && restInfo.Source.Cluster.Name != cluster.Name {
+		source.Cluster = &Cluster{Name: types.StringValue(restInfo.Source.Cluster.Name)}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), restInfo.UUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_endpoint"), source)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_endpoint"), &EndPoint{Path: types.StringValue(restInfo.Destination.Path)})...)
+	// the relationship already exists, use the default value to avoid replacing it
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("initialize"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
 }
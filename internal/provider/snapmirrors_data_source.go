@@ -213,7 +213,7 @@ func (d *SnapmirrorsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	cluster, err := interfaces.GetClusterInfo(errorHandler, *client)
 	if err != nil {
 		// error reporting done inside GetCluster
 		return
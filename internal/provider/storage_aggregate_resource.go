@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -41,20 +42,21 @@ type AggregateResource struct {
 
 // AggregateResourceModel describes the resource data model.
 type AggregateResourceModel struct {
-	CxProfileName types.String `tfsdk:"cx_profile_name"`
-	Name          types.String `tfsdk:"name"`
-	ID            types.String `tfsdk:"id"`
-	State         types.String `tfsdk:"state"`
-	Node          types.String `tfsdk:"node"`
-	DiskClass     types.String `tfsdk:"disk_class"`
-	DiskCount     types.Int64  `tfsdk:"disk_count"`
-	DiskSize      types.Int64  `tfsdk:"disk_size"`
-	DiskSizeUnit  types.String `tfsdk:"disk_size_unit"`
-	RaidSize      types.Int64  `tfsdk:"raid_size"`
-	RaidType      types.String `tfsdk:"raid_type"`
-	IsMirrored    types.Bool   `tfsdk:"is_mirrored"`
-	SnaplockType  types.String `tfsdk:"snaplock_type"`
-	Encryption    types.Bool   `tfsdk:"encryption"`
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	Name          types.String   `tfsdk:"name"`
+	ID            types.String   `tfsdk:"id"`
+	State         types.String   `tfsdk:"state"`
+	Node          types.String   `tfsdk:"node"`
+	DiskClass     types.String   `tfsdk:"disk_class"`
+	DiskCount     types.Int64    `tfsdk:"disk_count"`
+	DiskSize      types.Int64    `tfsdk:"disk_size"`
+	DiskSizeUnit  types.String   `tfsdk:"disk_size_unit"`
+	RaidSize      types.Int64    `tfsdk:"raid_size"`
+	RaidType      types.String   `tfsdk:"raid_type"`
+	IsMirrored    types.Bool     `tfsdk:"is_mirrored"`
+	SnaplockType  types.String   `tfsdk:"snaplock_type"`
+	Encryption    types.Bool     `tfsdk:"encryption"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -187,6 +189,14 @@ func (r *AggregateResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Whether to enable software encryption. This is equivalent to -encrypt-with-aggr-key when using the CLI.Requires a VE license.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -222,7 +232,12 @@ func (r *AggregateResource) Create(ctx context.Context, req resource.CreateReque
 
 	request.Name = data.Name.ValueString()
 
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -287,10 +302,15 @@ func (r *AggregateResource) Create(ctx context.Context, req resource.CreateReque
 	// So we need to wait until the aggregate is online.
 	waitTime := 1
 	for aggregate.State == "onlining" {
-		aggregate, err = interfaces.GetStorageAggregate(errorHandler, *client, aggregate.UUID)
+		uuid := aggregate.UUID
+		aggregate, err = interfaces.GetStorageAggregate(errorHandler, *client, uuid)
 		if err != nil {
 			return
 		}
+		if aggregate == nil {
+			errorHandler.MakeAndReportError("No aggregate found", fmt.Sprintf("aggregate %s not found.", uuid))
+			return
+		}
 		waitTime = ExpontentialBackoff(waitTime, 360)
 	}
 
@@ -321,6 +341,14 @@ func (r *AggregateResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -333,7 +361,7 @@ func (r *AggregateResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if aggregate == nil {
-		errorHandler.MakeAndReportError("No aggregate found", fmt.Sprintf("aggregate %s not found.", data.Name.ValueString()))
+		removeResourceNotFound(ctx, resp, "aggregate", data.Name.ValueString())
 		return
 	}
 	data.DiskCount = types.Int64Value(aggregate.BlockStorage.Primary.DiskCount)
@@ -363,7 +391,12 @@ func (r *AggregateResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	client, err := getRestClient(errorHandler, r.config, plan.CxProfileName)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, plan.CxProfileName, updateTimeout)
 	if err != nil {
 		return
 	}
@@ -434,7 +467,12 @@ func (r *AggregateResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -201,7 +201,7 @@ func (r *SnapshotPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 	if restInfo == nil {
-		errorHandler.MakeAndReportError("No snapshot policy found", fmt.Sprintf("snapshot policy  %s not found.", data.Name.ValueString()))
+		removeResourceNotFound(ctx, resp, "snapshot policy", data.Name.ValueString())
 		return
 	}
 
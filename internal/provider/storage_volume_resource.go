@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/mitchellh/mapstructure"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -61,6 +63,7 @@ type StorageVolumeResourceModel struct {
 	Efficiency     types.Object                      `tfsdk:"efficiency"`
 	SnapLock       types.Object                      `tfsdk:"snaplock"`
 	Analytics      types.Object                      `tfsdk:"analytics"`
+	Timeouts       timeouts.Value                    `tfsdk:"timeouts"`
 }
 
 // StorageVolumeResourceAggregates describes the analytics model.
@@ -324,6 +327,14 @@ func (r *StorageVolumeResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -376,6 +387,14 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -387,7 +406,19 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 	if err != nil {
 		return
 	}
+	if response == nil {
+		removeResourceNotFound(ctx, resp, "volume", data.Name.ValueString())
+		return
+	}
 
+	data.Name = types.StringValue(response.Name)
+	data.SVMName = types.StringValue(response.SVM.Name)
+	if len(data.Aggregates) == 0 {
+		// after an import, the aggregates are not known yet
+		for _, aggregate := range response.Aggregates {
+			data.Aggregates = append(data.Aggregates, StorageVolumeResourceAggregates{Name: types.StringValue(aggregate.Name)})
+		}
+	}
 	data.Comment = types.StringValue(response.Comment)
 	data.Encrypt = types.BoolValue(response.Encryption.Enabled)
 	data.State = types.StringValue(response.State)
@@ -414,17 +445,22 @@ func (r *StorageVolumeResource) Read(ctx context.Context, req resource.ReadReque
 		"logical_space":          types.ObjectType{AttrTypes: nestedElementTypes},
 	}
 	var sizeUnit string
-	var space StorageVolumeResourceSpace
-	diags := data.Space.As(ctx, &space, basetypes.ObjectAsOptions{})
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
-	}
-	if _, ok := interfaces.POW2BYTEMAP[space.SizeUnit.ValueString()]; !ok {
-		errorHandler.MakeAndReportError("error creating volume", fmt.Sprintf("invalid input for size_unit: %s, required one of: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb", space.SizeUnit.ValueString()))
-		return
+	if data.Space.IsNull() {
+		// after an import, use the largest unit for the size
+		_, sizeUnit = interfaces.ByteFormat(int64(response.Space.Size))
+	} else {
+		var space StorageVolumeResourceSpace
+		diags = data.Space.As(ctx, &space, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if _, ok := interfaces.POW2BYTEMAP[space.SizeUnit.ValueString()]; !ok {
+			errorHandler.MakeAndReportError("error creating volume", fmt.Sprintf("invalid input for size_unit: %s, required one of: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb", space.SizeUnit.ValueString()))
+			return
+		}
+		sizeUnit = space.SizeUnit.ValueString()
 	}
-	sizeUnit = space.SizeUnit.ValueString()
 
 	elements := map[string]attr.Value{
 		"size":                   types.Int64Value(int64(response.Space.Size / interfaces.POW2BYTEMAP[sizeUnit])),
@@ -693,7 +729,12 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -842,7 +883,12 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	client, err := getRestClient(errorHandler, r.config, plan.CxProfileName)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, plan.CxProfileName, updateTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -1037,7 +1083,12 @@ func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -1056,8 +1107,39 @@ func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
+// The ID is either svm_name,volume_name,cx_profile_name or the volume UUID.
 func (r *StorageVolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !strings.Contains(req.ID, ",") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	idParts, ok := splitImportID(req.ID, "svm_name,volume_name,cx_profile_name", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, types.StringValue(idParts[2]))
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, idParts[0])
+	if err != nil {
+		return
+	}
+	if svm == nil {
+		errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", idParts[0]))
+		return
+	}
+	volume, err := interfaces.GetStorageVolumeByName(errorHandler, *client, idParts[1], idParts[0])
+	if err != nil {
+		// error reporting done inside GetStorageVolumeByName
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), volume.UUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
 }
 
 func readVolume(ctx context.Context, client *restclient.RestClient, data *StorageVolumeResourceModel) diag.Diagnostics {
@@ -1070,6 +1152,10 @@ func readVolume(ctx context.Context, client *restclient.RestClient, data *Storag
 		allDiags.AddError("Error reading volume", returnedError.Error())
 		return allDiags
 	}
+	if response == nil {
+		allDiags.AddError("Error reading volume", fmt.Sprintf("volume %s not found", data.Name.ValueString()))
+		return allDiags
+	}
 	data.Comment = types.StringValue(response.Comment)
 	data.Encrypt = types.BoolValue(response.Encryption.Enabled)
 	data.State = types.StringValue(response.State)
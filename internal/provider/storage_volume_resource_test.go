@@ -8,11 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-var host string
-var admin string
-var password string
-
 func TestAccStorageVolumeResource(t *testing.T) {
+	testAccReplay(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -48,11 +45,9 @@ func TestAccStorageVolumeResource(t *testing.T) {
 }
 
 func testAccStorageVolumeResourceConfig(svm, volName string) string {
-	if host == "" || admin == "" || password == "" {
-		host = os.Getenv("TF_ACC_NETAPP_HOST2")
-		admin = os.Getenv("TF_ACC_NETAPP_USER")
-		password = os.Getenv("TF_ACC_NETAPP_PASS")
-	}
+	host := os.Getenv("TF_ACC_NETAPP_HOST2")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
 	if host == "" || admin == "" || password == "" {
 		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
 		os.Exit(1)
@@ -107,11 +102,9 @@ resource "netapp-ontap_storage_volume_resource" "example" {
 }
 
 func testAccStorageVolumeResourceConfigUpdate(svm, volName string) string {
-	if host == "" || admin == "" || password == "" {
-		host = os.Getenv("TF_ACC_NETAPP_HOST2")
-		admin = os.Getenv("TF_ACC_NETAPP_USER")
-		password = os.Getenv("TF_ACC_NETAPP_PASS")
-	}
+	host := os.Getenv("TF_ACC_NETAPP_HOST2")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
 	if host == "" || admin == "" || password == "" {
 		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
 		os.Exit(1)
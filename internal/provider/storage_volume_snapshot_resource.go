@@ -197,14 +197,26 @@ func (r *StorageVolumeSnapshotResource) Read(ctx context.Context, req resource.R
 	if err != nil {
 		return
 	}
+	if svm == nil {
+		removeResourceNotFound(ctx, resp, "svm", data.SVMName.ValueString())
+		return
+	}
 	volume, err := interfaces.GetUUIDVolumeByName(errorHandler, *client, svm.UUID, data.VolumeName.ValueString())
 	if err != nil {
 		return
 	}
+	if volume == nil {
+		removeResourceNotFound(ctx, resp, "volume", data.VolumeName.ValueString())
+		return
+	}
 	snapshot, err := interfaces.GetStorageVolumeSnapshot(errorHandler, *client, volume.UUID, data.ID.ValueString())
 	if err != nil {
 		return
 	}
+	if snapshot == nil {
+		removeResourceNotFound(ctx, resp, "snapshot", data.Name.ValueString())
+		return
+	}
 	data.Name = types.StringValue(snapshot.Name)
 
 	// Write logs using the tflog package
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -45,6 +46,7 @@ type SvmResourceModel struct {
 	Aggregates     []types.String `tfsdk:"aggregates"`
 	MaxVolumes     types.String   `tfsdk:"max_volumes"`
 	ID             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -104,6 +106,14 @@ func (r *SvmResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -185,7 +195,12 @@ func (r *SvmResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	errorHandler = utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, createTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -213,6 +228,14 @@ func (r *SvmResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
@@ -226,7 +249,8 @@ func (r *SvmResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 	if svm == nil {
-		errorHandler.MakeAndReportError("No Svm found", "No SVM found")
+		removeResourceNotFound(ctx, resp, "svm", data.Name.ValueString())
+		return
 	}
 	data.Name = types.StringValue(svm.Name)
 	data.ID = types.StringValue(svm.UUID)
@@ -253,7 +277,12 @@ func (r *SvmResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, updateTimeout)
 	if err != nil {
 		return
 	}
@@ -360,7 +389,12 @@ func (r *SvmResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.config.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := getRestClientWithTimeout(errorHandler, r.config, data.CxProfileName, deleteTimeout)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
@@ -10,6 +10,7 @@ import (
 )
 
 func TestAccSvmResource(t *testing.T) {
+	testAccReplay(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -20,7 +21,7 @@ func TestAccSvmResource(t *testing.T) {
 					// Check to see the svm name is correct,
 					resource.TestCheckResourceAttr("netapp-ontap_svm_resource.example", "name", "tfsvm4"),
 					// Check to see if Ipspace is set correctly
-					resource.TestCheckResourceAttr("netapp-ontap_svm_resource.example", "ipspace", "ansibleIpspace_newname"),
+					resource.TestCheckResourceAttr("netapp-ontap_svm_resource.example", "ipspace", "tfsvm_ipspace"),
 					// Check that a ID has been set (we don't know what the vaule is as it changes
 					resource.TestCheckResourceAttrSet("netapp-ontap_svm_resource.example", "id"),
 					resource.TestCheckResourceAttr("netapp-ontap_svm_resource.example", "comment", "test")),
@@ -88,10 +89,22 @@ provider "netapp-ontap" {
   ]
 }
 
+# the ipspace is created for the test, as there is no dedicated resource
+resource "netapp-ontap_rest_api_resource" "ipspace" {
+  cx_profile_name = "cluster4"
+  api = "network/ipspaces"
+  create_body = jsonencode({name = "tfsvm_ipspace"})
+  key_query = {
+    name = "tfsvm_ipspace"
+  }
+  delete_path = "network/ipspaces/{uuid}"
+}
+
 resource "netapp-ontap_svm_resource" "example" {
   cx_profile_name = "cluster4"
   name = "%s"
-  ipspace = "ansibleIpspace_newname"
+  ipspace = "tfsvm_ipspace"
+  depends_on = [netapp-ontap_rest_api_resource.ipspace]
   comment = "%s"
   snapshot_policy = "%s"
   //subtype = "dp_destination"
@@ -117,6 +117,10 @@ func (d *GoPrefixDataSource) Read(ctx context.Context, req datasource.ReadReques
 		// error reporting done inside GetGoPrefix
 		return
 	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("No tag prefix found", fmt.Sprintf("tag prefix %s not found.", data.Name.ValueString()))
+		return
+	}
 
 	data.Name = types.StringValue(restInfo.Name)
 
@@ -123,6 +123,10 @@ func (r *GoPrefixResource) Read(ctx context.Context, req resource.ReadRequest, r
 		// error reporting done inside GetGoPrefix
 		return
 	}
+	if restInfo == nil {
+		removeResourceNotFound(ctx, resp, "tag prefix", data.Name.ValueString())
+		return
+	}
 
 	data.Name = types.StringValue(restInfo.Name)
 
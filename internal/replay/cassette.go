@@ -0,0 +1,152 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+// Cassette is a list of HTTP interactions recorded against one or more ONTAP clusters
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+	mutex        sync.Mutex
+}
+
+// Interaction is a request sent to a cluster, and the response returned by the cluster
+type Interaction struct {
+	// Server identifies the cluster, eg the name of the environment variable holding the host name
+	Server   string   `json:"server"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request describes a recorded request.  Query is normalized, so that the order of the parameters does not matter.
+type Request struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Response describes a recorded response
+type Response struct {
+	StatusCode int             `json:"status_code"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from a JSON file
+func LoadCassette(fileName string) (*Cassette, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(content, &cassette); err != nil {
+		return nil, fmt.Errorf("error reading cassette %s: %s", fileName, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to a JSON file, creating the directory if needed
+func (c *Cassette) Save(fileName string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, append(content, '\n'), 0644)
+}
+
+// Servers returns the servers found in the cassette, in order of first use
+func (c *Cassette) Servers() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var servers []string
+	seen := map[string]bool{}
+	for _, interaction := range c.Interactions {
+		if !seen[interaction.Server] {
+			seen[interaction.Server] = true
+			servers = append(servers, interaction.Server)
+		}
+	}
+	return servers
+}
+
+// add appends an interaction, it is safe for concurrent use
+func (c *Cassette) add(interaction Interaction) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+}
+
+// forServer returns the interactions recorded for a server
+func (c *Cassette) forServer(server string) []Interaction {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var interactions []Interaction
+	for _, interaction := range c.Interactions {
+		if interaction.Server == server {
+			interactions = append(interactions, interaction)
+		}
+	}
+	return interactions
+}
+
+// normalizeQuery sorts the query parameters by name
+func normalizeQuery(rawQuery string) (string, error) {
+	if rawQuery == "" {
+		return "", nil
+	}
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", err
+	}
+	return values.Encode(), nil
+}
+
+// normalizeBody compacts a JSON body, an empty body is returned as nil
+func normalizeBody(body []byte) (json.RawMessage, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, nil
+	}
+	var buffer bytes.Buffer
+	if err := json.Compact(&buffer, body); err != nil {
+		return nil, fmt.Errorf("body is not valid JSON: %s", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// equalJSON compares two JSON documents, ignoring the order of the keys and the white spaces
+func equalJSON(a json.RawMessage, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var valueA, valueB interface{}
+	if err := json.Unmarshal(a, &valueA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &valueB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(valueA, valueB)
+}
+
+// replaceAll replaces each key of replacements with its value
+func replaceAll(content []byte, replacements map[string]string) []byte {
+	for old, new := range replacements {
+		if old == "" {
+			continue
+		}
+		content = bytes.ReplaceAll(content, []byte(old), []byte(new))
+	}
+	return content
+}
@@ -0,0 +1,139 @@
+package replay
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+)
+
+// forwardedHeaders are copied from the provider request to the cluster request.  Other headers are not recorded.
+var forwardedHeaders = []string{"Accept", "Authorization", "Content-Type"}
+
+// Recorder is a local TLS proxy forwarding requests to a cluster, and adding each request and response to a cassette.
+// Authentication headers are forwarded but not recorded, and the values of secrets are replaced with placeholders.
+type Recorder struct {
+	server     *httptest.Server
+	cassette   *Cassette
+	name       string
+	target     string
+	secrets    map[string]string
+	httpClient *http.Client
+	errors     []error
+	mutex      sync.Mutex
+}
+
+// NewRecorder starts a proxy recording the interactions with target, a host name or host:port, as server in cassette.
+// secrets maps a secret name to its value, each value is replaced with Placeholder(name) in the cassette.
+// The certificate of the cluster is not validated.
+func NewRecorder(cassette *Cassette, server string, target string, secrets map[string]string) *Recorder {
+	r := &Recorder{
+		cassette: cassette,
+		name:     server,
+		target:   target,
+		secrets:  secrets,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+	}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	return r
+}
+
+// Host returns the address of the proxy, as host:port
+func (r *Recorder) Host() string {
+	serverURL, _ := url.Parse(r.server.URL)
+	return serverURL.Host
+}
+
+// Close stops the proxy
+func (r *Recorder) Close() {
+	r.server.Close()
+}
+
+// Errors returns an error for each interaction that could not be forwarded or recorded
+func (r *Recorder) Errors() []error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]error(nil), r.errors...)
+}
+
+func (r *Recorder) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	requestBody, err := io.ReadAll(req.Body)
+	if err != nil {
+		r.fail(w, fmt.Errorf("record: error reading request %s %s: %s", req.Method, req.URL, err))
+		return
+	}
+	targetURL := url.URL{Scheme: "https", Host: r.target, Path: req.URL.Path, RawQuery: req.URL.RawQuery}
+	forward, err := http.NewRequestWithContext(req.Context(), req.Method, targetURL.String(), bytes.NewReader(requestBody))
+	if err != nil {
+		r.fail(w, fmt.Errorf("record: error building request %s %s: %s", req.Method, req.URL, err))
+		return
+	}
+	for _, header := range forwardedHeaders {
+		if value := req.Header.Get(header); value != "" {
+			forward.Header.Set(header, value)
+		}
+	}
+	response, err := r.httpClient.Do(forward)
+	if err != nil {
+		r.fail(w, fmt.Errorf("record: error sending request %s %s: %s", req.Method, req.URL, err))
+		return
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		r.fail(w, fmt.Errorf("record: error reading response for %s %s: %s", req.Method, req.URL, err))
+		return
+	}
+	if err := r.record(req, requestBody, response.StatusCode, responseBody); err != nil {
+		r.mutex.Lock()
+		r.errors = append(r.errors, err)
+		r.mutex.Unlock()
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(response.StatusCode)
+	w.Write(responseBody)
+}
+
+// record adds the interaction to the cassette, replacing secrets with placeholders
+func (r *Recorder) record(req *http.Request, requestBody []byte, statusCode int, responseBody []byte) error {
+	redactions := map[string]string{}
+	for name, value := range r.secrets {
+		redactions[value] = Placeholder(name)
+	}
+	query, err := normalizeQuery(req.URL.RawQuery)
+	if err != nil {
+		return fmt.Errorf("record: invalid query for %s %s: %s", req.Method, req.URL, err)
+	}
+	request := Request{
+		Method: req.Method,
+		Path:   string(replaceAll([]byte(req.URL.Path), redactions)),
+		Query:  string(replaceAll([]byte(query), redactions)),
+	}
+	if request.Body, err = normalizeBody(replaceAll(requestBody, redactions)); err != nil {
+		return fmt.Errorf("record: invalid request body for %s: %s", request, err)
+	}
+	response := Response{StatusCode: statusCode}
+	if response.Body, err = normalizeBody(replaceAll(responseBody, redactions)); err != nil {
+		return fmt.Errorf("record: invalid response body for %s: %s", request, err)
+	}
+	r.cassette.add(Interaction{Server: r.name, Request: request, Response: response})
+	return nil
+}
+
+// fail reports an error to the provider and records it
+func (r *Recorder) fail(w http.ResponseWriter, err error) {
+	r.mutex.Lock()
+	r.errors = append(r.errors, err)
+	r.mutex.Unlock()
+	writeError(w, err)
+}
@@ -0,0 +1,224 @@
+package replay
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// send sends a request to a replay server or a recorder, and returns the status code and the body of the response
+func send(t *testing.T, host string, method string, path string, body string) (int, string) {
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, "https://"+host+path, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth("admin", "secret")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(content)
+}
+
+func testCassette() *Cassette {
+	return &Cassette{Interactions: []Interaction{
+		{
+			Server:   "cluster1",
+			Request:  Request{Method: "GET", Path: "/api/storage/volumes", Query: "fields=uuid&name=vol1"},
+			Response: Response{StatusCode: 200, Body: []byte(`{"num_records":1,"records":[{"uuid":"1234"}]}`)},
+		},
+		{
+			Server:   "cluster1",
+			Request:  Request{Method: "PATCH", Path: "/api/storage/volumes/1234", Body: []byte(`{"comment":"{{COMMENT}}","size":100}`)},
+			Response: Response{StatusCode: 202, Body: []byte(`{"job":{"uuid":"5678"}}`)},
+		},
+		{
+			Server:   "cluster1",
+			Request:  Request{Method: "GET", Path: "/api/storage/volumes", Query: "fields=uuid&name=vol1"},
+			Response: Response{StatusCode: 200, Body: []byte(`{"num_records":0,"records":[]}`)},
+		},
+		{
+			Server:   "cluster2",
+			Request:  Request{Method: "GET", Path: "/api/cluster"},
+			Response: Response{StatusCode: 200, Body: []byte(`{"name":"cluster2"}`)},
+		},
+	}}
+}
+
+func TestServer(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "test_query_order", method: "GET", path: "/api/storage/volumes?name=vol1&fields=uuid", wantStatus: 200, wantBody: `{"num_records":1,"records":[{"uuid":"1234"}]}`},
+		{name: "test_body_key_order", method: "PATCH", path: "/api/storage/volumes/1234", body: `{"size": 100, "comment": "replayed"}`, wantStatus: 202, wantBody: `{"job":{"uuid":"5678"}}`},
+		{name: "test_repeated_request", method: "GET", path: "/api/storage/volumes?fields=uuid&name=vol1", wantStatus: 200, wantBody: `{"num_records":0,"records":[]}`},
+		{name: "test_used", method: "GET", path: "/api/storage/volumes?fields=uuid&name=vol1", wantStatus: 500},
+		{name: "test_other_server", method: "GET", path: "/api/cluster", wantStatus: 500},
+	}
+	server := NewServer(testCassette(), "cluster1", map[string]string{"COMMENT": "replayed"})
+	defer server.Close()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := send(t, server.Host(), tt.method, tt.path, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("Server status = %d, want %d, body %s", status, tt.wantStatus, body)
+			}
+			if tt.wantBody != "" && body != tt.wantBody {
+				t.Errorf("Server body = %s, want %s", body, tt.wantBody)
+			}
+		})
+	}
+	if errors := server.Errors(); len(errors) != 2 {
+		t.Errorf("Server.Errors() = %v, want 2 errors", errors)
+	}
+	if unused := server.Unused(); len(unused) != 0 {
+		t.Errorf("Server.Unused() = %v, want none", unused)
+	}
+}
+
+func TestServer_mismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{name: "test_method", method: "POST", path: "/api/storage/volumes/1234", body: `{"comment":"replayed","size":100}`},
+		{name: "test_path", method: "PATCH", path: "/api/storage/volumes/4321", body: `{"comment":"replayed","size":100}`},
+		{name: "test_query", method: "GET", path: "/api/storage/volumes?fields=uuid&name=vol2"},
+		{name: "test_missing_query", method: "GET", path: "/api/storage/volumes"},
+		{name: "test_body", method: "PATCH", path: "/api/storage/volumes/1234", body: `{"comment":"replayed","size":200}`},
+		{name: "test_missing_body", method: "PATCH", path: "/api/storage/volumes/1234"},
+		{name: "test_placeholder_value", method: "PATCH", path: "/api/storage/volumes/1234", body: `{"comment":"{{COMMENT}}","size":100}`},
+		{name: "test_invalid_body", method: "PATCH", path: "/api/storage/volumes/1234", body: `{"comment":`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(testCassette(), "cluster1", map[string]string{"COMMENT": "replayed"})
+			defer server.Close()
+			status, body := send(t, server.Host(), tt.method, tt.path, tt.body)
+			if status != 500 || !strings.Contains(body, "replay:") {
+				t.Errorf("Server status = %d, body %s, want a replay error", status, body)
+			}
+			if errors := server.Errors(); len(errors) != 1 {
+				t.Errorf("Server.Errors() = %v, want 1 error", errors)
+			}
+			if unused := server.Unused(); len(unused) != 3 {
+				t.Errorf("Server.Unused() = %d interactions, want 3", len(unused))
+			}
+		})
+	}
+}
+
+func TestServer_mismatchDiagnostics(t *testing.T) {
+	server := NewServer(testCassette(), "cluster1", nil)
+	defer server.Close()
+	client, err := restclient.NewClient(context.Background(), restclient.ConnectionProfile{Hostname: server.Host(), Username: "admin", Password: "secret"}, "replay/test", 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := diag.Diagnostics{}
+	errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+	if _, err := interfaces.GetCluster(errorHandler, *client); err == nil {
+		t.Fatalf("GetCluster() expected error")
+	}
+	// the reason of the mismatch is reported to the user, not only the status code
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "replay: no recorded interaction for GET /api/cluster") {
+		t.Errorf("GetCluster() diagnostics = %v, want the replay error", diags)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	var gotAuth bool
+	cluster := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, gotAuth = r.BasicAuth()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "POST" {
+			w.WriteHeader(201)
+			w.Write([]byte(`{"num_records": 1, "records": [{"name": "vol1", "comment": "key ABCD-1234"}]}`))
+			return
+		}
+		w.Write([]byte(`{"name": "cluster1", "management_interfaces": [{"ip": {"address": "` + r.Host + `"}}]}`))
+	}))
+	defer cluster.Close()
+	clusterURL, err := url.Parse(cluster.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cassette := &Cassette{}
+	recorder := NewRecorder(cassette, "TF_ACC_NETAPP_HOST", clusterURL.Host, map[string]string{"TF_ACC_NETAPP_HOST": clusterURL.Host, "TF_ACC_NETAPP_LICENSE": "ABCD-1234"})
+	if status, body := send(t, recorder.Host(), "GET", "/api/cluster?fields=name,management_interfaces", ""); status != 200 || !strings.Contains(body, clusterURL.Host) {
+		t.Errorf("Recorder status = %d, body %s, want the cluster response", status, body)
+	}
+	if status, _ := send(t, recorder.Host(), "POST", "/api/storage/volumes?return_records=true", `{"name": "vol1", "comment": "key ABCD-1234"}`); status != 201 {
+		t.Errorf("Recorder status = %d, want 201", status)
+	}
+	recorder.Close()
+	if !gotAuth {
+		t.Errorf("Recorder did not forward the authorization header")
+	}
+	if errors := recorder.Errors(); len(errors) != 0 {
+		t.Errorf("Recorder.Errors() = %v", errors)
+	}
+
+	fileName := filepath.Join(t.TempDir(), "cassettes", "TestRecorder.json")
+	if err := cassette.Save(fileName); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadCassette(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Interactions) != 2 {
+		t.Fatalf("LoadCassette() = %d interactions, want 2", len(loaded.Interactions))
+	}
+	for _, interaction := range loaded.Interactions {
+		for _, secret := range []string{clusterURL.Host, "ABCD-1234", "secret"} {
+			if strings.Contains(interaction.Request.String(), secret) || strings.Contains(string(interaction.Response.Body), secret) {
+				t.Errorf("cassette contains secret %s: %s", secret, interaction.Request)
+			}
+		}
+	}
+	if got := loaded.Servers(); len(got) != 1 || got[0] != "TF_ACC_NETAPP_HOST" {
+		t.Errorf("Cassette.Servers() = %v", got)
+	}
+
+	server := NewServer(loaded, "TF_ACC_NETAPP_HOST", map[string]string{"TF_ACC_NETAPP_LICENSE": "replayed"})
+	defer server.Close()
+	if status, body := send(t, server.Host(), "GET", "/api/cluster?fields=name,management_interfaces", ""); status != 200 || !strings.Contains(body, Placeholder("TF_ACC_NETAPP_HOST")) {
+		t.Errorf("Server status = %d, body %s, want the recorded response", status, body)
+	}
+	if status, body := send(t, server.Host(), "POST", "/api/storage/volumes?return_records=true", `{"name":"vol1","comment":"key replayed"}`); status != 201 || !strings.Contains(body, "key replayed") {
+		t.Errorf("Server status = %d, body %s, want the recorded response", status, body)
+	}
+	if unused := server.Unused(); len(unused) != 0 {
+		t.Errorf("Server.Unused() = %v, want none", unused)
+	}
+}
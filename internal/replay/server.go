@@ -0,0 +1,164 @@
+// Package replay records the REST calls sent by the provider to ONTAP clusters, and replays them with a local server,
+// so that acceptance tests can run without a cluster.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+)
+
+// Placeholder returns the text stored in a cassette in place of the value of a secret
+func Placeholder(name string) string {
+	return "{{" + name + "}}"
+}
+
+// Server is a fake ONTAP REST server replaying the interactions recorded for a server in a cassette.
+// A request is matched against the recorded interactions that were not used yet, in order, using the method, the path,
+// the query and the JSON body.  Unexpected requests get an error response, and are reported by Errors.
+type Server struct {
+	server       *httptest.Server
+	interactions []Interaction
+	used         []bool
+	values       map[string]string
+	errors       []error
+	mutex        sync.Mutex
+}
+
+// NewServer starts a TLS server replaying the interactions recorded for server.
+// Placeholders found in the cassette are replaced using values, a map of secret name to value.
+// values is read for each request, so it can be completed after the server is started.
+func NewServer(cassette *Cassette, server string, values map[string]string) *Server {
+	s := &Server{
+		interactions: cassette.forServer(server),
+		values:       values,
+	}
+	s.used = make([]bool, len(s.interactions))
+	s.server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Host returns the address of the server, as host:port
+func (s *Server) Host() string {
+	serverURL, _ := url.Parse(s.server.URL)
+	return serverURL.Host
+}
+
+// Close stops the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Errors returns an error for each request that did not match a recorded interaction
+func (s *Server) Errors() []error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]error(nil), s.errors...)
+}
+
+// Unused returns the recorded interactions that were not replayed
+func (s *Server) Unused() []Interaction {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var unused []Interaction
+	for index, interaction := range s.interactions {
+		if !s.used[index] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := readRequest(r)
+	if err != nil {
+		s.reject(w, fmt.Errorf("replay: invalid request %s %s: %s", r.Method, r.URL, err))
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for index, interaction := range s.interactions {
+		if s.used[index] || !s.matches(interaction.Request, request) {
+			continue
+		}
+		s.used[index] = true
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(interaction.Response.StatusCode)
+		w.Write(replaceAll(interaction.Response.Body, s.placeholders()))
+		return
+	}
+	s.errors = append(s.errors, fmt.Errorf("replay: no recorded interaction for %s", request))
+	writeError(w, s.errors[len(s.errors)-1])
+}
+
+// reject reports a request that cannot be parsed
+func (s *Server) reject(w http.ResponseWriter, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.errors = append(s.errors, err)
+	writeError(w, err)
+}
+
+// matches checks whether a request matches a recorded request, after replacing placeholders
+func (s *Server) matches(recorded Request, request Request) bool {
+	placeholders := s.placeholders()
+	if recorded.Method != request.Method ||
+		string(replaceAll([]byte(recorded.Path), placeholders)) != request.Path ||
+		string(replaceAll([]byte(recorded.Query), placeholders)) != request.Query {
+		return false
+	}
+	return equalJSON(replaceAll(recorded.Body, placeholders), request.Body)
+}
+
+// placeholders maps each placeholder to its value
+func (s *Server) placeholders() map[string]string {
+	placeholders := map[string]string{}
+	for name, value := range s.values {
+		placeholders[Placeholder(name)] = value
+	}
+	return placeholders
+}
+
+// String describes a request in error messages
+func (r Request) String() string {
+	description := r.Method + " " + r.Path
+	if r.Query != "" {
+		description += "?" + r.Query
+	}
+	if len(r.Body) != 0 {
+		description += " with body " + string(r.Body)
+	}
+	return description
+}
+
+// readRequest builds a normalized request, reading the request body
+func readRequest(r *http.Request) (Request, error) {
+	query, err := normalizeQuery(r.URL.RawQuery)
+	if err != nil {
+		return Request{}, err
+	}
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		return Request{}, err
+	}
+	body, err := normalizeBody(content)
+	if err != nil {
+		return Request{}, err
+	}
+	return Request{Method: r.Method, Path: r.URL.Path, Query: query, Body: body}, nil
+}
+
+// writeError returns an error using the ONTAP REST error structure.
+// The code is not 0, as the REST client ignores an error with code 0, and the message would not be reported.
+func writeError(w http.ResponseWriter, err error) {
+	content, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{"code": "1", "message": err.Error()},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write(content)
+}
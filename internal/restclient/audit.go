@@ -0,0 +1,127 @@
+package restclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
+)
+
+// AuditLog records the calls that modify a cluster, POST, PATCH and DELETE, as JSON lines.
+// A single AuditLog is shared by all the clients of a provider, and entries are written as a whole.
+type AuditLog struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// AuditEntry describes a call that modified a cluster.  The query and the body are redacted.
+type AuditEntry struct {
+	Time       string                 `json:"time"`
+	Resource   string                 `json:"resource"`
+	Hostname   string                 `json:"hostname"`
+	Method     string                 `json:"method"`
+	Path       string                 `json:"path"`
+	Query      string                 `json:"query,omitempty"`
+	Body       map[string]interface{} `json:"body,omitempty"`
+	StatusCode int                    `json:"status_code"`
+	JobUUID    string                 `json:"job_uuid,omitempty"`
+	DurationMs int64                  `json:"duration_ms"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// NewAuditLog returns an AuditLog writing to writer
+func NewAuditLog(writer io.Writer) *AuditLog {
+	return &AuditLog{writer: writer}
+}
+
+// OpenAuditLog returns an AuditLog appending to fileName.  The file is created if needed, and only readable by its owner.
+func OpenAuditLog(fileName string) (*AuditLog, error) {
+	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log: %s", err)
+	}
+	return NewAuditLog(file), nil
+}
+
+// Close closes the file opened by OpenAuditLog
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if closer, ok := a.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Record writes an entry, as a single line
+func (a *AuditLog) Record(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err = a.writer.Write(append(line, '\n'))
+	return err
+}
+
+// WithAuditLog returns a copy of the client, recording POST, PATCH and DELETE calls in auditLog
+func (r *RestClient) WithAuditLog(auditLog *AuditLog) *RestClient {
+	client := *r
+	client.auditLog = auditLog
+	return &client
+}
+
+// audit records a POST, PATCH or DELETE call, including the time spent waiting for its job, if any
+func (r *RestClient) audit(method string, baseURL string, query *RestQuery, body map[string]interface{}, statusCode int, response RestResponse, start time.Time, err error) {
+	if r.auditLog == nil {
+		return
+	}
+	entry := AuditEntry{
+		Time:       start.UTC().Format(time.RFC3339),
+		Resource:   resourceFromTag(r.tag),
+		Hostname:   r.connectionProfile.Hostname,
+		Method:     method,
+		Path:       baseURL,
+		Body:       httpclient.RedactBody(body),
+		StatusCode: statusCode,
+		JobUUID:    jobUUIDs(response),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if query != nil {
+		entry.Query = httpclient.RedactQuery(query.Values)
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if err := r.auditLog.Record(entry); err != nil {
+		tflog.Error(r.ctx, fmt.Sprintf("unable to write audit log entry for %s %s: %s", method, baseURL, err))
+	}
+}
+
+// resourceFromTag returns the resource name from a telemetry tag, eg storage_volume_resource for TerraformONTAP/storage_volume_resource/1.0.0
+func resourceFromTag(tag string) string {
+	parts := strings.Split(tag, "/")
+	if len(parts) == 3 {
+		return parts[1]
+	}
+	return tag
+}
+
+// jobUUIDs returns the UUID of the job or jobs started by a call, separated with commas
+func jobUUIDs(response RestResponse) string {
+	var uuids []string
+	if response.Job != nil {
+		uuids = append(uuids, fmt.Sprintf("%v", response.Job["uuid"]))
+	}
+	for _, job := range response.Jobs {
+		uuids = append(uuids, fmt.Sprintf("%v", job["uuid"]))
+	}
+	return strings.Join(uuids, ",")
+}
@@ -0,0 +1,83 @@
+package restclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
+)
+
+func TestRestClient_audit(t *testing.T) {
+	accepted := RestResponse{NumRecords: 0, Job: map[string]any{"uuid": "1234"}}
+	success := RestResponse{NumRecords: 1, Records: []map[string]any{{"state": "success"}}}
+	body := map[string]any{"name": "admin", "password": "secret"}
+	genericError := errors.New("generic error for UT")
+	tests := []struct {
+		name      string
+		method    string
+		query     string
+		responses []MockResponse
+		want      AuditEntry
+	}{
+		{name: "test_post_job", method: "POST", responses: []MockResponse{
+			{ExpectedMethod: "POST", ExpectedURL: "security/accounts", ExpectedBody: body, StatusCode: 202, Response: accepted},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/1234", StatusCode: 200, Response: success},
+		}, want: AuditEntry{Resource: "security_account_resource", Method: "POST", Path: "security/accounts", Query: "return_timeout=60",
+			Body: map[string]any{"name": "admin", "password": httpclient.RedactedValue}, StatusCode: 200, JobUUID: "1234"}},
+		{name: "test_patch_error", method: "PATCH", responses: []MockResponse{
+			{ExpectedMethod: "PATCH", ExpectedURL: "security/accounts", ExpectedBody: body, StatusCode: 400, Err: genericError},
+		}, want: AuditEntry{Resource: "security_account_resource", Method: "PATCH", Path: "security/accounts", Query: "return_timeout=60",
+			Body: map[string]any{"name": "admin", "password": httpclient.RedactedValue}, StatusCode: 400, Error: genericError.Error()}},
+		{name: "test_cli_query", method: "PATCH", query: "-password=secret&user=admin", responses: []MockResponse{
+			{ExpectedMethod: "PATCH", ExpectedURL: "security/accounts", ExpectedBody: body, StatusCode: 200, Response: RestResponse{}},
+		}, want: AuditEntry{Resource: "security_account_resource", Method: "PATCH", Path: "security/accounts", Query: "-password=%2A%2A%2A%2A%2A%2A%2A%2A&return_timeout=60&user=admin",
+			Body: map[string]any{"name": "admin", "password": httpclient.RedactedValue}, StatusCode: 200}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			r, err := NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			r = r.WithContext(r.ctx, "TerraformONTAP/security_account_resource/1.0.0").WithAuditLog(NewAuditLog(&buffer))
+			var query *RestQuery
+			if tt.query != "" {
+				values, err := url.ParseQuery(tt.query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				query = &RestQuery{Values: values}
+			}
+			if tt.method == "POST" {
+				r.CallCreateMethod("security/accounts", query, body)
+			} else {
+				r.CallUpdateMethod("security/accounts", query, body)
+			}
+			if strings.Contains(buffer.String(), "secret") {
+				t.Errorf("audit log contains a secret: %s", buffer.String())
+			}
+			lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+			if len(lines) != 1 {
+				t.Fatalf("audit log has %d lines, want 1: %s", len(lines), buffer.String())
+			}
+			var got AuditEntry
+			if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Time == "" {
+				t.Errorf("audit entry time is not set")
+			}
+			got.Time = ""
+			got.DurationMs = 0
+			if got.Resource != tt.want.Resource || got.Method != tt.want.Method || got.Path != tt.want.Path || got.Query != tt.want.Query ||
+				got.StatusCode != tt.want.StatusCode || got.JobUUID != tt.want.JobUUID || got.Error != tt.want.Error || got.Body["password"] != tt.want.Body["password"] {
+				t.Errorf("audit entry = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package restclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ClusterInfo is the cluster metadata, retrieved once per connection profile
+type ClusterInfo struct {
+	Name    string
+	UUID    string
+	Version ClusterVersion
+}
+
+// ClusterVersion is the ONTAP version running on the cluster, eg 9.13.1
+type ClusterVersion struct {
+	Full       string
+	Generation int
+	Major      int
+	Minor      int
+}
+
+// clusterInfoCache is shared by all copies of a client, see WithContext
+type clusterInfoCache struct {
+	mu   sync.Mutex
+	info *ClusterInfo
+}
+
+// GetClusterInfo returns the cluster information cached for the connection profile.
+// On first use, fetch is called to retrieve it from ONTAP.  fetch is called again on the next use if it fails.
+func (r *RestClient) GetClusterInfo(fetch func() (ClusterInfo, error)) (ClusterInfo, error) {
+	r.clusterInfo.mu.Lock()
+	defer r.clusterInfo.mu.Unlock()
+	if r.clusterInfo.info != nil {
+		return *r.clusterInfo.info, nil
+	}
+	info, err := fetch()
+	if err != nil {
+		return ClusterInfo{}, err
+	}
+	r.clusterInfo.info = &info
+	return info, nil
+}
+
+// ParseClusterVersion parses a version like 9.10.1, the minor number is optional
+func ParseClusterVersion(version string) (ClusterVersion, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return ClusterVersion{}, fmt.Errorf("invalid ONTAP version %s, expecting generation.major.minor, eg 9.10.1", version)
+	}
+	numbers := make([]int, 3)
+	for index, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return ClusterVersion{}, fmt.Errorf("invalid ONTAP version %s, expecting generation.major.minor, eg 9.10.1", version)
+		}
+		numbers[index] = number
+	}
+	return ClusterVersion{Full: version, Generation: numbers[0], Major: numbers[1], Minor: numbers[2]}, nil
+}
+
+// AtLeast returns true if v is the same or a more recent version than minimum
+func (v ClusterVersion) AtLeast(minimum ClusterVersion) bool {
+	if v.Generation != minimum.Generation {
+		return v.Generation > minimum.Generation
+	}
+	if v.Major != minimum.Major {
+		return v.Major > minimum.Major
+	}
+	return v.Minor >= minimum.Minor
+}
+
+// String returns the version as generation.major.minor
+func (v ClusterVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Generation, v.Major, v.Minor)
+}
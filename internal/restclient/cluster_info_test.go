@@ -0,0 +1,90 @@
+package restclient
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRestClient_GetClusterInfo(t *testing.T) {
+	c, err := NewMockedRestClient(t, nil)
+	if err != nil {
+		panic(err)
+	}
+	want := ClusterInfo{Name: "cluster1", UUID: "1234", Version: ClusterVersion{Full: "NetApp Release 9.13.1", Generation: 9, Major: 13, Minor: 1}}
+	calls := 0
+	failing := func() (ClusterInfo, error) {
+		calls++
+		return ClusterInfo{}, errors.New("generic error for UT")
+	}
+	fetch := func() (ClusterInfo, error) {
+		calls++
+		return want, nil
+	}
+	if _, err := c.GetClusterInfo(failing); err == nil {
+		t.Errorf("RestClient.GetClusterInfo() expected error")
+	}
+	// the value is cached, and shared with copies of the client
+	for _, client := range []*RestClient{c, c, c.WithContext(c.ctx, "other/version")} {
+		got, err := client.GetClusterInfo(fetch)
+		if err != nil {
+			t.Errorf("RestClient.GetClusterInfo() error = %v", err)
+			return
+		}
+		if got != want {
+			t.Errorf("RestClient.GetClusterInfo() = %v, want %v", got, want)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("RestClient.GetClusterInfo() expected fetch to be called twice, got %d", calls)
+	}
+}
+
+func TestParseClusterVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{name: "test_full", version: "9.10.1", want: "9.10.1", wantErr: false},
+		{name: "test_no_minor", version: "9.8", want: "9.8.0", wantErr: false},
+		{name: "test_invalid", version: "9", wantErr: true},
+		{name: "test_not_a_number", version: "9.x.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseClusterVersion(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseClusterVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseClusterVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterVersion_AtLeast(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		minimum string
+		want    bool
+	}{
+		{name: "test_equal", version: "9.10.1", minimum: "9.10.1", want: true},
+		{name: "test_minor", version: "9.10.0", minimum: "9.10.1", want: false},
+		{name: "test_major", version: "9.8.0", minimum: "9.10.1", want: false},
+		{name: "test_newer_major", version: "9.11.0", minimum: "9.10.1", want: true},
+		{name: "test_generation", version: "10.0.0", minimum: "9.10.1", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, _ := ParseClusterVersion(tt.version)
+			minimum, _ := ParseClusterVersion(tt.minimum)
+			if got := version.AtLeast(minimum); got != tt.want {
+				t.Errorf("ClusterVersion.AtLeast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
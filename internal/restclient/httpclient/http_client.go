@@ -2,10 +2,12 @@ package httpclient
 
 import (
 	"context"
-	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,11 +23,32 @@ type HTTPClient struct {
 
 // HTTPProfile defines the connection attributes to build the base URL and authentication header
 type HTTPProfile struct {
-	APIRoot       string
-	Hostname      string
+	// APIRoot is the path of the REST API, eg api, or ontap1/api behind a reverse proxy
+	APIRoot  string
+	Hostname string
+	// Scheme defaults to https, and Port to the default port for the scheme
+	Scheme string
+	Port   int
+	// ProxyURL is the URL of a forward proxy, eg http://proxy:3128.  HTTPS_PROXY and NO_PROXY are used if not set.
+	ProxyURL      string
 	Username      string
 	Password      string
 	ValidateCerts bool
+	// ClientCertificate and ClientKey enable certificate authentication, as PEM content or file names
+	ClientCertificate string
+	ClientKey         string
+	// CACertificate is a bundle of trusted CAs, as PEM content or file name.  The system store is used if not set.
+	CACertificate string
+	// TLSServerName overrides the name used to verify the server certificate
+	TLSServerName string
+	// CertificateFingerprint pins the SHA-256 fingerprint of the server certificate
+	CertificateFingerprint string
+	MinTLSVersion          string
+	// ConnectTimeout, TLSHandshakeTimeout and ResponseTimeout use defaults when set to 0.
+	// ResponseTimeout is the time to wait for the response headers once the request is sent.
+	ConnectTimeout      time.Duration
+	TLSHandshakeTimeout time.Duration
+	ResponseTimeout     time.Duration
 }
 
 // Do sends the API Request, parses the response as JSON, and returns the HTTP status code as int, the "result" value as byte
@@ -42,12 +65,18 @@ func (c *HTTPClient) Do(baseURL string, req *Request) (int, []byte, error) {
 	if err != nil {
 		return statusCode, nil, err
 	}
-	tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, httpReq.URL.String()), map[string]any{"body": req.Body})
+	redactedURL := RedactURL(httpReq.URL)
+	tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, redactedURL), map[string]any{"body": RedactBody(req.Body)})
 	httpRes, err := c.httpClient.Do(httpReq)
 	if httpRes != nil {
 		statusCode = httpRes.StatusCode
 	}
 	if err != nil {
+		// the error includes the URL, and is reported to the user
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactedURL
+		}
 		tflog.Error(c.ctx, fmt.Sprintf("HTTP request failed: %s, statusCode: %d, err raw:%#v", err, statusCode, err))
 		return statusCode, nil, err
 	}
@@ -64,26 +93,94 @@ func (c *HTTPClient) Do(baseURL string, req *Request) (int, []byte, error) {
 		return httpRes.StatusCode, nil, fmt.Errorf("no result returned in REST response.  statusCode %d", statusCode)
 	}
 
-	tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"res": string(body)})
+	tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, redactedURL, statusCode), map[string]any{"res": RedactJSON(body)})
 
 	return httpRes.StatusCode, body, nil
 }
 
 // NewClient creates a new HTTP client
-func NewClient(ctx context.Context, cxProfile HTTPProfile, tag string) HTTPClient {
+func NewClient(ctx context.Context, cxProfile HTTPProfile, tag string) (HTTPClient, error) {
 	client := HTTPClient{
 		cxProfile: cxProfile,
 		ctx:       ctx,
 		tag:       tag,
 	}
-	client.httpClient = client.create()
-	return client
+	httpClient, err := client.create()
+	if err != nil {
+		return client, err
+	}
+	client.httpClient = httpClient
+	return client, nil
 }
 
-// create configures and creates the http client
-func (c HTTPClient) create() http.Client {
-	if !c.cxProfile.ValidateCerts {
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+// WithContext returns a copy of the client bound to ctx, and using tag for telemetry.
+// The copy shares the HTTP transport, and its connection pool, with the original client.
+func (c HTTPClient) WithContext(ctx context.Context, tag string) HTTPClient {
+	c.ctx = ctx
+	c.tag = tag
+	return c
+}
+
+// create configures and creates the http client, with a transport specific to the connection profile
+func (c HTTPClient) create() (http.Client, error) {
+	tlsConfig, err := buildTLSConfig(c.cxProfile)
+	if err != nil {
+		tflog.Error(c.ctx, err.Error())
+		return http.Client{}, err
+	}
+	connectTimeout := c.cxProfile.ConnectTimeout
+	if connectTimeout == 0 {
+		connectTimeout = 30 * time.Second
+	}
+	tlsHandshakeTimeout := c.cxProfile.TLSHandshakeTimeout
+	if tlsHandshakeTimeout == 0 {
+		tlsHandshakeTimeout = 10 * time.Second
+	}
+	responseTimeout := c.cxProfile.ResponseTimeout
+	if responseTimeout == 0 {
+		responseTimeout = 120 * time.Second
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.DialContext = (&net.Dialer{
+		Timeout:   connectTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = tlsHandshakeTimeout
+	// ONTAP holds the response to a POST, PATCH or DELETE until the job completes or return_timeout expires
+	transport.ResponseHeaderTimeout = responseTimeout
+	if c.cxProfile.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(c.cxProfile.ProxyURL)
+		if err != nil {
+			tflog.Error(c.ctx, err.Error())
+			return http.Client{}, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return http.Client{Transport: transport}, nil
+}
+
+// useCertificateAuth returns true when a client certificate or key is set, basic authentication is not used in this case
+func (c HTTPClient) useCertificateAuth() bool {
+	return c.cxProfile.ClientCertificate != "" || c.cxProfile.ClientKey != ""
+}
+
+// parseProxyURL checks that the proxy URL has a supported scheme and a host
+func parseProxyURL(proxyURL string) (*url.URL, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		// url.Error includes the URL, and the proxy password if any
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("invalid proxy URL: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5" {
+		return nil, fmt.Errorf("invalid proxy URL %s, scheme must be http, https or socks5", u.Redacted())
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %s, host is required", u.Redacted())
 	}
-	return http.Client{Timeout: 120 * time.Second}
+	return u, nil
 }
@@ -0,0 +1,127 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the value of a sensitive field in logs and in the audit log
+const RedactedValue = "********"
+
+// sensitiveFields holds the names of the fields whose value is never logged.
+// Fields ending with one of sensitiveSuffixes, eg authentication_password, are also redacted.
+var sensitiveFields = struct {
+	mu     sync.RWMutex
+	fields map[string]bool
+}{fields: map[string]bool{
+	"password":    true,
+	"passphrase":  true,
+	"secret":      true,
+	"private_key": true,
+}}
+
+var sensitiveSuffixes = []string{"password", "passphrase", "secret"}
+
+// RegisterSensitiveFields adds fields to redact, eg the attributes marked as sensitive in the resource schemas
+func RegisterSensitiveFields(fields ...string) {
+	sensitiveFields.mu.Lock()
+	defer sensitiveFields.mu.Unlock()
+	for _, field := range fields {
+		sensitiveFields.fields[strings.ToLower(field)] = true
+	}
+}
+
+// IsSensitiveField returns true if the value of field must not be logged.  The comparison ignores case.
+func IsSensitiveField(field string) bool {
+	field = strings.ToLower(field)
+	sensitiveFields.mu.RLock()
+	defer sensitiveFields.mu.RUnlock()
+	if sensitiveFields.fields[field] {
+		return true
+	}
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactBody returns a copy of body, with the values of sensitive fields replaced with RedactedValue, at any depth.
+// body is converted to JSON first, so that nested structures are redacted as they are sent.
+func RedactBody(body map[string]interface{}) map[string]interface{} {
+	if body == nil {
+		return nil
+	}
+	content, err := json.Marshal(body)
+	if err != nil {
+		// the request cannot be sent either, do not take the risk to log it
+		return map[string]interface{}{"body": RedactedValue}
+	}
+	return redactJSONObject(content)
+}
+
+// RedactQuery encodes query, with the values of sensitive parameters replaced with RedactedValue.
+// A leading dash is ignored, as the private CLI accepts parameters such as -password.
+func RedactQuery(query url.Values) string {
+	redacted := url.Values{}
+	for name, values := range query {
+		if IsSensitiveField(strings.TrimLeft(name, "-")) {
+			redacted[name] = []string{RedactedValue}
+			continue
+		}
+		redacted[name] = values
+	}
+	return redacted.Encode()
+}
+
+// RedactURL returns u as a string, with the values of sensitive query parameters redacted
+func RedactURL(u *url.URL) string {
+	redacted := *u
+	redacted.RawQuery = RedactQuery(u.Query())
+	return redacted.String()
+}
+
+// RedactJSON redacts a JSON encoded response.  It is returned unchanged if it is not a JSON object.
+func RedactJSON(content []byte) string {
+	redacted, err := json.Marshal(redactJSONObject(content))
+	if err != nil || redacted == nil || string(redacted) == "null" {
+		return string(content)
+	}
+	return string(redacted)
+}
+
+// redactJSONObject decodes and redacts a JSON object, nil is returned if it is not an object
+func redactJSONObject(content []byte) map[string]interface{} {
+	var body map[string]interface{}
+	if err := json.Unmarshal(content, &body); err != nil || body == nil {
+		return nil
+	}
+	redacted, _ := redactValue(body).(map[string]interface{})
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(typed))
+		for key, subValue := range typed {
+			if IsSensitiveField(key) && subValue != nil {
+				redacted[key] = RedactedValue
+			} else {
+				redacted[key] = redactValue(subValue)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(typed))
+		for index, subValue := range typed {
+			redacted[index] = redactValue(subValue)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
@@ -0,0 +1,80 @@
+package httpclient
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	type chap struct {
+		User     string `json:"user"`
+		Password string `json:"password"`
+	}
+	RegisterSensitiveFields("license_keys")
+	tests := []struct {
+		name string
+		body map[string]interface{}
+		want map[string]interface{}
+	}{
+		{name: "test_nil", body: nil, want: nil},
+		{name: "test_top_level", body: map[string]interface{}{"name": "admin", "password": "secret"},
+			want: map[string]interface{}{"name": "admin", "password": RedactedValue}},
+		{name: "test_suffix_and_case", body: map[string]interface{}{"authentication_password": "secret", "Passphrase": "secret", "comment": "password"},
+			want: map[string]interface{}{"authentication_password": RedactedValue, "Passphrase": RedactedValue, "comment": "password"}},
+		{name: "test_registered", body: map[string]interface{}{"license_keys": []string{"AAAA", "BBBB"}},
+			want: map[string]interface{}{"license_keys": RedactedValue}},
+		{name: "test_nested_struct", body: map[string]interface{}{"chap": map[string]interface{}{"inbound": chap{User: "user1", Password: "secret"}}},
+			want: map[string]interface{}{"chap": map[string]interface{}{"inbound": map[string]interface{}{"user": "user1", "password": RedactedValue}}}},
+		{name: "test_list", body: map[string]interface{}{"records": []map[string]interface{}{{"name": "user1", "password": "secret"}}},
+			want: map[string]interface{}{"records": []interface{}{map[string]interface{}{"name": "user1", "password": RedactedValue}}}},
+		{name: "test_null", body: map[string]interface{}{"password": nil},
+			want: map[string]interface{}{"password": nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactBody(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RedactBody() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "test_object", content: `{"name": "admin", "password": "secret"}`, want: `{"name":"admin","password":"********"}`},
+		{name: "test_not_json", content: `<html>bad gateway</html>`, want: `<html>bad gateway</html>`},
+		{name: "test_not_object", content: `[1, 2]`, want: `[1, 2]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactJSON([]byte(tt.content)); got != tt.want {
+				t.Errorf("RedactJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query url.Values
+		want  string
+	}{
+		{name: "test_empty", query: url.Values{}, want: ""},
+		{name: "test_not_sensitive", query: url.Values{"fields": {"name,password"}, "name": {"vol1"}}, want: "fields=name%2Cpassword&name=vol1"},
+		{name: "test_sensitive", query: url.Values{"password": {"secret"}, "user": {"admin"}}, want: "password=%2A%2A%2A%2A%2A%2A%2A%2A&user=admin"},
+		{name: "test_cli_parameter", query: url.Values{"-auth_password": {"secret"}}, want: "-auth_password=%2A%2A%2A%2A%2A%2A%2A%2A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactQuery(tt.query); got != tt.want {
+				t.Errorf("RedactQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package httpclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // Request represents a request to a REST API
@@ -33,14 +37,21 @@ func (r *Request) BuildHTTPReq(c *HTTPClient, baseURL string) (*http.Request, er
 		}
 		body = bytes.NewReader(bodyJSON)
 	}
-	req, err = http.NewRequest(r.Method, url, body)
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// the request is canceled when the Terraform operation is canceled or times out
+	req, err = http.NewRequestWithContext(ctx, r.Method, url, body)
 
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(c.cxProfile.Username, c.cxProfile.Password)
+	if !c.useCertificateAuth() {
+		req.SetBasicAuth(c.cxProfile.Username, c.cxProfile.Password)
+	}
 	// telemetry header
 	req.Header.Set("X-Dot-Client-App", c.tag)
 	// TODO: low pty: add support for form data (require to create a file)
@@ -48,7 +59,7 @@ func (r *Request) BuildHTTPReq(c *HTTPClient, baseURL string) (*http.Request, er
 	return req, err
 }
 
-// BuildURL using Host, ApiRoot, baseURL, uuid, any query element
+// BuildURL using Scheme, Host, Port, ApiRoot, baseURL, uuid, any query element
 func (r *Request) BuildURL(c *HTTPClient, baseURL string, uuid string) (string, error) {
 	var err error
 	if c == nil {
@@ -61,9 +72,17 @@ func (r *Request) BuildURL(c *HTTPClient, baseURL string, uuid string) (string,
 	if err != nil {
 		return "", err
 	}
+	scheme := c.cxProfile.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	host := c.cxProfile.Hostname
+	if c.cxProfile.Port != 0 {
+		host = net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(c.cxProfile.Port))
+	}
 	u := &url.URL{
-		Scheme: "https",
-		Host:   c.cxProfile.Hostname,
+		Scheme: scheme,
+		Host:   host,
 		Path:   c.cxProfile.APIRoot,
 	}
 	u = u.JoinPath(baseURL, uuid)
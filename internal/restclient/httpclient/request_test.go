@@ -14,6 +14,21 @@ func TestRequest_BuildURL(t *testing.T) {
 	client := &HTTPClient{
 		cxProfile: cxProfile,
 	}
+	clientProxied := &HTTPClient{
+		cxProfile: HTTPProfile{
+			Hostname: "bastion",
+			APIRoot:  "ontap1/api",
+			Scheme:   "http",
+			Port:     8080,
+		},
+	}
+	clientIPv6 := &HTTPClient{
+		cxProfile: HTTPProfile{
+			Hostname: "[fd20::1]",
+			APIRoot:  "api",
+			Port:     8443,
+		},
+	}
 	clientEmptyValues := &HTTPClient{
 		cxProfile: HTTPProfile{},
 	}
@@ -39,6 +54,8 @@ func TestRequest_BuildURL(t *testing.T) {
 		{name: "test1", fields: fields{Method: "GET", Body: nil, Query: nil}, args: args{c: client, baseURL: "cluster"}, want: "https://host/api/cluster", wantErr: false},
 		{name: "test2", fields: fields{Method: "GET", Body: nil, Query: query}, args: args{c: client, baseURL: "cluster", uuid: "123"}, want: "https://host/api/cluster/123?fields=f1%2Cf2", wantErr: false},
 		{name: "test3", fields: fields{Method: "GET", Body: nil, Query: query}, args: args{c: nil, baseURL: "cluster", uuid: "123"}, want: "", wantErr: true},
+		{name: "test_scheme_port_api_root", fields: fields{Method: "GET", Body: nil, Query: query}, args: args{c: clientProxied, baseURL: "cluster"}, want: "http://bastion:8080/ontap1/api/cluster?fields=f1%2Cf2", wantErr: false},
+		{name: "test_ipv6_port", fields: fields{Method: "GET", Body: nil, Query: nil}, args: args{c: clientIPv6, baseURL: "cluster"}, want: "https://[fd20::1]:8443/api/cluster", wantErr: false},
 		{name: "test4", fields: fields{Method: "GET", Body: nil, Query: query}, args: args{c: clientEmptyValues, baseURL: "cluster", uuid: "123"}, want: "", wantErr: true},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,121 @@
+package httpclient
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// tlsVersions maps the supported min_tls_version values
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// buildTLSConfig creates the TLS configuration for a connection profile.
+// It is specific to the profile, so that settings like validate_certs do not leak to other profiles.
+func buildTLSConfig(cxProfile HTTPProfile) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: !cxProfile.ValidateCerts,
+		ServerName:         cxProfile.TLSServerName,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if cxProfile.MinTLSVersion != "" {
+		version, ok := tlsVersions[cxProfile.MinTLSVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported min_tls_version %s, expecting 1.2 or 1.3", cxProfile.MinTLSVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+	if cxProfile.CACertificate != "" {
+		caPEM, err := readPEM(cxProfile.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca_certificate: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("error reading ca_certificate: no valid certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cxProfile.CertificateFingerprint != "" {
+		fingerprint, err := normalizeFingerprint(cxProfile.CertificateFingerprint)
+		if err != nil {
+			return nil, err
+		}
+		// VerifyConnection is called even when InsecureSkipVerify is set, so the pin is always enforced
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return &CertificatePinError{Message: fmt.Sprintf("no certificate presented by the server, expecting fingerprint %s", fingerprint)}
+			}
+			sum := sha256.Sum256(cs.PeerCertificates[0].Raw)
+			if got := hex.EncodeToString(sum[:]); got != fingerprint {
+				return &CertificatePinError{Message: fmt.Sprintf("server certificate fingerprint %s does not match certificate_fingerprint %s", got, fingerprint)}
+			}
+			return nil
+		}
+	}
+	if cxProfile.ClientCertificate != "" || cxProfile.ClientKey != "" {
+		certificate, err := loadClientCertificate(cxProfile.ClientCertificate, cxProfile.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// CertificatePinError reports a server certificate that does not match certificate_fingerprint
+type CertificatePinError struct {
+	Message string
+}
+
+func (e *CertificatePinError) Error() string {
+	return e.Message
+}
+
+// normalizeFingerprint accepts a SHA-256 fingerprint in hexadecimal, with or without colons, and returns it in lowercase without colons
+func normalizeFingerprint(value string) (string, error) {
+	fingerprint := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(value), ":", ""))
+	if decoded, err := hex.DecodeString(fingerprint); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("certificate_fingerprint %s is not a valid SHA-256 fingerprint", value)
+	}
+	return fingerprint, nil
+}
+
+// readPEM returns value if it is PEM encoded content, otherwise value is used as a file name and the file content is returned
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	content, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read PEM file: %s", err)
+	}
+	return content, nil
+}
+
+// loadClientCertificate builds a certificate for mutual TLS authentication.
+// certificate and key can be PEM encoded content or file names.
+func loadClientCertificate(certificate string, key string) (tls.Certificate, error) {
+	if certificate == "" || key == "" {
+		return tls.Certificate{}, fmt.Errorf("client_certificate and client_key are required together for certificate authentication")
+	}
+	certPEM, err := readPEM(certificate)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error reading client_certificate: %s", err)
+	}
+	keyPEM, err := readPEM(key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error reading client_key: %s", err)
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error loading client certificate and key: %s", err)
+	}
+	return cert, nil
+}
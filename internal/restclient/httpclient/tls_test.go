@@ -0,0 +1,188 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestCertificate returns a self-signed certificate and its key, PEM encoded
+func newTestCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestLoadClientCertificate(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "admin.pem")
+	keyFile := filepath.Join(dir, "admin.key")
+	if err := os.WriteFile(certFile, []byte(certPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, []byte(keyPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		certificate string
+		key         string
+		wantErr     bool
+	}{
+		{name: "test_pem_content", certificate: certPEM, key: keyPEM, wantErr: false},
+		{name: "test_pem_files", certificate: certFile, key: keyFile, wantErr: false},
+		{name: "test_mixed", certificate: certFile, key: keyPEM, wantErr: false},
+		{name: "test_missing_key", certificate: certPEM, key: "", wantErr: true},
+		{name: "test_missing_file", certificate: filepath.Join(dir, "missing.pem"), key: keyPEM, wantErr: true},
+		{name: "test_mismatch", certificate: keyPEM, key: certPEM, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadClientCertificate(tt.certificate, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loadClientCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequest_BuildHTTPReq_auth(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	basicClient := &HTTPClient{cxProfile: HTTPProfile{Hostname: "host", APIRoot: "api", Username: "admin", Password: "secret"}}
+	certClient := &HTTPClient{cxProfile: HTTPProfile{Hostname: "host", APIRoot: "api", Username: "admin", ClientCertificate: certPEM, ClientKey: keyPEM}}
+	request := Request{Method: "GET"}
+
+	req, err := request.BuildHTTPReq(basicClient, "cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := req.BasicAuth(); !ok {
+		t.Errorf("Request.BuildHTTPReq() expected basic authentication header")
+	}
+	req, err = request.BuildHTTPReq(certClient, "cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := req.BasicAuth(); ok {
+		t.Errorf("Request.BuildHTTPReq() unexpected basic authentication header with certificate authentication")
+	}
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "cluster1"}`))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+	otherCAPEM, _ := newTestCertificate(t)
+
+	tests := []struct {
+		name       string
+		cxProfile  HTTPProfile
+		wantConfig bool
+		wantErr    bool
+	}{
+		{name: "test_system_store", cxProfile: HTTPProfile{ValidateCerts: true}, wantConfig: true, wantErr: true},
+		{name: "test_no_validation", cxProfile: HTTPProfile{ValidateCerts: false}, wantConfig: true, wantErr: false},
+		{name: "test_ca_certificate", cxProfile: HTTPProfile{ValidateCerts: true, CACertificate: caPEM}, wantConfig: true, wantErr: false},
+		{name: "test_other_ca_certificate", cxProfile: HTTPProfile{ValidateCerts: true, CACertificate: otherCAPEM}, wantConfig: true, wantErr: true},
+		{name: "test_server_name", cxProfile: HTTPProfile{ValidateCerts: true, CACertificate: caPEM, TLSServerName: "example.com"}, wantConfig: true, wantErr: false},
+		{name: "test_wrong_server_name", cxProfile: HTTPProfile{ValidateCerts: true, CACertificate: caPEM, TLSServerName: "cluster1.example.org"}, wantConfig: true, wantErr: true},
+		{name: "test_fingerprint", cxProfile: HTTPProfile{ValidateCerts: false, CertificateFingerprint: fingerprint}, wantConfig: true, wantErr: false},
+		{name: "test_fingerprint_uppercase_colons", cxProfile: HTTPProfile{ValidateCerts: false, CertificateFingerprint: colonSeparated(fingerprint)}, wantConfig: true, wantErr: false},
+		{name: "test_wrong_fingerprint", cxProfile: HTTPProfile{ValidateCerts: false, CertificateFingerprint: hex.EncodeToString(make([]byte, 32))}, wantConfig: true, wantErr: true},
+		{name: "test_invalid_fingerprint", cxProfile: HTTPProfile{CertificateFingerprint: "12:34"}, wantConfig: false, wantErr: true},
+		{name: "test_min_tls_version", cxProfile: HTTPProfile{ValidateCerts: false, MinTLSVersion: "1.3"}, wantConfig: true, wantErr: false},
+		{name: "test_invalid_min_tls_version", cxProfile: HTTPProfile{MinTLSVersion: "1.0"}, wantConfig: false, wantErr: true},
+		{name: "test_invalid_ca_certificate", cxProfile: HTTPProfile{CACertificate: "-----BEGIN CERTIFICATE-----"}, wantConfig: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cxProfile.Hostname = serverURL.Host
+			tt.cxProfile.APIRoot = "api"
+			c, err := NewClient(context.Background(), tt.cxProfile, "test")
+			if (err == nil) != tt.wantConfig {
+				t.Errorf("NewClient() error = %v, wantConfig %v", err, tt.wantConfig)
+				return
+			}
+			if err != nil {
+				return
+			}
+			_, _, err = c.Do("cluster", &Request{Method: "GET"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTTPClient.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// colonSeparated formats a fingerprint like openssl x509 -fingerprint
+func colonSeparated(fingerprint string) string {
+	var parts []string
+	for i := 0; i < len(fingerprint); i += 2 {
+		parts = append(parts, fingerprint[i:i+2])
+	}
+	return strings.ToUpper(strings.Join(parts, ":"))
+}
+
+func TestNewClient_transportIsolation(t *testing.T) {
+	insecure, err := NewClient(context.Background(), HTTPProfile{ValidateCerts: false}, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secure, err := NewClient(context.Background(), HTTPProfile{ValidateCerts: true}, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !insecure.httpClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("NewClient() expected InsecureSkipVerify with validate_certs false")
+	}
+	if secure.httpClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("NewClient() validate_certs false leaked to another profile")
+	}
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil && config.InsecureSkipVerify {
+		t.Errorf("NewClient() validate_certs false leaked to http.DefaultTransport")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,13 +17,30 @@ import (
 
 // ConnectionProfile describes out to reach a cluster or svm
 type ConnectionProfile struct {
-	// TODO: add certs in addition to basic authentication
-	// TODO: Add Timeout (currently hardcoded to 10 seconds)
-	Hostname              string
-	Username              string
-	Password              string
-	ValidateCerts         bool
-	MaxConcurrentRequests int
+	Hostname               string
+	Port                   int
+	Scheme                 string
+	APIRoot                string
+	ProxyURL               string
+	Username               string
+	Password               string
+	ValidateCerts          bool
+	MaxConcurrentRequests  int
+	PageSize               int
+	RecordLimit            int
+	Retry                  RetryPolicy
+	ClientCertificate      string
+	ClientKey              string
+	CACertificate          string
+	TLSServerName          string
+	CertificateFingerprint string
+	MinTLSVersion          string
+	ConnectTimeout         time.Duration
+	TLSHandshakeTimeout    time.Duration
+	// ResponseTimeout is the time to wait for the response headers, it must be greater than ReturnTimeout, defaults to ReturnTimeout + 60 seconds
+	ResponseTimeout time.Duration
+	// ReturnTimeout is the time in seconds ONTAP waits for a job to complete before returning, defaults to 60
+	ReturnTimeout int
 }
 
 // RestClient to interact with the ONTAP REST API
@@ -32,89 +51,80 @@ type RestClient struct {
 	httpClient            httpclient.HTTPClient
 	requestSlots          chan int
 	mode                  string
-	responses             []MockResponse
+	mock                  *mockResponses
 	jobCompletionTimeOut  int
+	pageSize              int
+	recordLimit           int
+	retryPolicy           RetryPolicy
+	returnTimeout         int
 	tag                   string
+	auditLog              *AuditLog
+	// clusterInfo is shared by all copies of the client
+	clusterInfo *clusterInfoCache
 }
 
 // CallCreateMethod returns response from POST results.  An error is reported if an error is received.
 func (r *RestClient) CallCreateMethod(baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
-	if query == nil {
-		query = r.NewQuery()
-	}
-	// TODO: make this a connection parameter ?
-	query.Set("return_timeout", "60")
-	statusCode, response, err := r.callAPIMethod("POST", baseURL, query, body)
-	if err != nil {
-		tflog.Debug(r.ctx, fmt.Sprintf("CallCreateMethod request failed %#v", statusCode))
-		return statusCode, RestResponse{}, err
-	}
-
-	if response.Job != nil {
-		statusCode, _, err = r.Wait(response.Job["uuid"].(string))
-		if err != nil {
-			return statusCode, RestResponse{}, err
-		}
-	} else if response.Jobs != nil {
-		for _, v := range response.Jobs {
-			statusCode, _, err = r.Wait(v["uuid"].(string))
-			if err != nil {
-				return statusCode, RestResponse{}, err
-			}
-		}
-	}
-	return statusCode, response, err
+	return r.callAndWait("POST", baseURL, query, body)
 }
 
 // CallUpdateMethod returns response from PATCH results.  An error is reported if an error is received.
 func (r *RestClient) CallUpdateMethod(baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
-	if query == nil {
-		query = r.NewQuery()
+	return r.callAndWait("PATCH", baseURL, query, body)
+}
+
+// CallDeleteMethod returns response from DELETE results.  An error is reported if an error is received.
+func (r *RestClient) CallDeleteMethod(baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	return r.callAndWait("DELETE", baseURL, query, body)
+}
+
+// callAndWait sends a POST, PATCH or DELETE request, waits for the job to complete if any, and records the call in the audit log.
+func (r *RestClient) callAndWait(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	// do not add return_timeout to the caller's query
+	query = query.Clone()
+	query.Set("return_timeout", strconv.Itoa(r.returnTimeout))
+	start := time.Now()
+	statusCode, response, err := r.callAPIMethod(method, baseURL, query, body)
+	if err != nil {
+		tflog.Debug(r.ctx, fmt.Sprintf("%s %s request failed %#v", method, baseURL, statusCode))
+	} else {
+		statusCode, err = r.waitOnCompletion(statusCode, response)
 	}
-	// TODO: make this a connection parameter ?
-	query.Set("return_timeout", "60")
-	statusCode, response, err := r.callAPIMethod("PATCH", baseURL, query, body)
+	r.audit(method, baseURL, query, body, statusCode, response, start, err)
 	if err != nil {
-		tflog.Debug(r.ctx, fmt.Sprintf("CallUpdateMethod request failed %#v", statusCode))
 		return statusCode, RestResponse{}, err
 	}
+	return statusCode, response, err
+}
 
+// waitOnCompletion waits for the job or jobs reported in an asynchronous response, if any.
+// An error is reported if a job fails or does not complete in time.
+func (r *RestClient) waitOnCompletion(statusCode int, response RestResponse) (int, error) {
+	var err error
 	if response.Job != nil {
 		statusCode, _, err = r.Wait(response.Job["uuid"].(string))
 		if err != nil {
-			return statusCode, RestResponse{}, err
+			return statusCode, err
 		}
 	} else if response.Jobs != nil {
 		for _, v := range response.Jobs {
 			statusCode, _, err = r.Wait(v["uuid"].(string))
 			if err != nil {
-				return statusCode, RestResponse{}, err
+				return statusCode, err
 			}
 		}
 	}
-	return statusCode, response, err
-}
-
-// CallDeleteMethod returns response from DELETE results.  An error is reported if an error is received.
-func (r *RestClient) CallDeleteMethod(baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
-	if query == nil {
-		query = r.NewQuery()
-	}
-	// TODO: make this a connection parameter ?
-	query.Set("return_timeout", "60")
-	statusCode, response, err := r.callAPIMethod("DELETE", baseURL, query, body)
-	if err != nil {
-		tflog.Debug(r.ctx, fmt.Sprintf("CallDeleteMethod request failed %#v", statusCode))
-		return statusCode, RestResponse{}, err
-	}
-
-	// TODO: handle waitOnCompletion
-	return statusCode, response, err
+	return statusCode, nil
 }
 
 // GetNilOrOneRecord returns nil if no record is found or a single record.  An error is reported if multiple records are received.
+// A GET on an object that does not exist, eg svm/svms/<uuid>, also returns nil without error.
 func (r *RestClient) GetNilOrOneRecord(baseURL string, query *RestQuery, body map[string]interface{}) (int, map[string]interface{}, error) {
 	statusCode, response, err := r.callAPIMethod("GET", baseURL, query, body)
+	if isRecordNotFound(err) {
+		tflog.Debug(r.ctx, fmt.Sprintf("GET %s: object not found - %s", baseURL, err))
+		return statusCode, nil, nil
+	}
 	if err != nil {
 		return statusCode, nil, err
 	}
@@ -130,20 +140,85 @@ func (r *RestClient) GetNilOrOneRecord(baseURL string, query *RestQuery, body ma
 }
 
 // GetZeroOrMoreRecords returns a list of records.
+// If the response is split in several pages, the next pages are retrieved and merged.
 func (r *RestClient) GetZeroOrMoreRecords(baseURL string, query *RestQuery, body map[string]interface{}) (int, []map[string]interface{}, error) {
-	statusCode, response, err := r.callAPIMethod("GET", baseURL, query, body)
+	statusCode, response, err := r.getAllPages(baseURL, query, body)
 	if err != nil {
 		return statusCode, nil, err
 	}
 	return statusCode, response.Records, err
 }
 
+// getAllPages follows the next links reported by ONTAP, and returns a single response with all records.
+// The number of records is capped by recordLimit, if set.
+func (r *RestClient) getAllPages(baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	if r.pageSize > 0 && (query == nil || query.Get("max_records") == "") {
+		// the caller's query is not modified, as it may be reused
+		query = query.Clone()
+		query.Set("max_records", strconv.Itoa(r.pageSize))
+	}
+	statusCode, response, err := r.callAPIMethod("GET", baseURL, query, body)
+	if err != nil {
+		return statusCode, RestResponse{}, err
+	}
+	records := response.Records
+	nextLink := response.NextLink
+	for nextLink != "" {
+		if r.recordLimit > 0 && len(records) >= r.recordLimit {
+			break
+		}
+		nextURL, nextQuery, err := r.parseNextLink(nextLink)
+		if err != nil {
+			return statusCode, RestResponse{}, err
+		}
+		tflog.Debug(r.ctx, fmt.Sprintf("retrieving next page: %s, records so far: %d", nextLink, len(records)))
+		statusCode, response, err = r.callAPIMethod("GET", nextURL, nextQuery, body)
+		if err != nil {
+			return statusCode, RestResponse{}, err
+		}
+		records = append(records, response.Records...)
+		nextLink = response.NextLink
+	}
+	if r.recordLimit > 0 && len(records) > r.recordLimit {
+		tflog.Warn(r.ctx, fmt.Sprintf("GET %s returned more than %d records, extra records are ignored", baseURL, r.recordLimit))
+		records = records[:r.recordLimit]
+	}
+	response.Records = records
+	response.NumRecords = len(records)
+	response.NextLink = ""
+	return statusCode, response, nil
+}
+
+// parseNextLink splits a next href, eg /api/storage/volumes?max_records=20&start.uuid=..., into a baseURL and a query.
+// The href is always rooted at /api by ONTAP, this prefix is removed as it is added back by the HTTP client.
+func (r *RestClient) parseNextLink(href string) (string, *RestQuery, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		msg := fmt.Sprintf("unable to parse next link %s: %s", href, err)
+		tflog.Error(r.ctx, msg)
+		return "", nil, errors.New(msg)
+	}
+	baseURL := strings.TrimPrefix(u.Path, "/")
+	baseURL = strings.TrimPrefix(baseURL, "api/")
+	query := r.NewQuery()
+	query.Values = u.Query()
+	return baseURL, query, nil
+}
+
 // callAPIMethod can be used to make a request to any REST API method, receiving response as bytes
+// Transient failures are retried according to the retry policy in the connection profile.
 func (r *RestClient) callAPIMethod(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	return r.callAPIMethodWithRetries(method, baseURL, query, body)
+}
+
+// callAPIMethodOnce sends a single request
+func (r *RestClient) callAPIMethodOnce(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
 	if r.mode == "mock" {
 		return r.mockCallAPIMethod(method, baseURL, query, body)
 	}
-	r.waitForAvailableSlot()
+	if err := r.waitForAvailableSlot(); err != nil {
+		return -1, RestResponse{ErrorType: "canceled"}, err
+	}
 	defer r.releaseSlot()
 
 	values := url.Values{}
@@ -156,9 +231,13 @@ func (r *RestClient) callAPIMethod(method string, baseURL string, query *RestQue
 		Query:  values,
 	})
 
-	// TODO: error handling for HTTTP status code >=300
-	// TODO: handle async calls (job in response)
-	return r.unmarshalResponse(statusCode, response, httpClientErr)
+	statusCode, restResponse, err := r.unmarshalResponse(statusCode, response, httpClientErr)
+	var ontapErr *ONTAPError
+	if errors.As(err, &ontapErr) {
+		ontapErr.Method = method
+		ontapErr.URL = baseURL
+	}
+	return statusCode, restResponse, err
 }
 
 // NewClient creates a new REST client and a supporting HTTP client
@@ -170,26 +249,81 @@ func NewClient(ctx context.Context, cxProfile ConnectionProfile, tag string, job
 		tflog.Error(ctx, msg)
 		return nil, errors.New(msg)
 	}
-	httpProfile.APIRoot = "api"
+	// the API root can be a sub-path behind a reverse proxy, eg ontap1/api
+	httpProfile.APIRoot = strings.Trim(cxProfile.APIRoot, "/")
+	if httpProfile.APIRoot == "" {
+		httpProfile.APIRoot = "api"
+	}
+	if cxProfile.Scheme != "" && cxProfile.Scheme != "https" && cxProfile.Scheme != "http" {
+		msg := fmt.Sprintf("scheme must be https or http, got %s", cxProfile.Scheme)
+		tflog.Error(ctx, msg)
+		return nil, errors.New(msg)
+	}
+	if cxProfile.Port < 0 || cxProfile.Port > 65535 {
+		msg := fmt.Sprintf("port must be between 1 and 65535, got %d", cxProfile.Port)
+		tflog.Error(ctx, msg)
+		return nil, errors.New(msg)
+	}
 	maxConcurrentRequests := cxProfile.MaxConcurrentRequests
 	if maxConcurrentRequests == 0 {
 		maxConcurrentRequests = 6
 	}
+	if cxProfile.PageSize < 0 || cxProfile.RecordLimit < 0 {
+		msg := fmt.Sprintf("page size and record limit cannot be negative, got %d and %d", cxProfile.PageSize, cxProfile.RecordLimit)
+		tflog.Error(ctx, msg)
+		return nil, errors.New(msg)
+	}
+	returnTimeout := cxProfile.ReturnTimeout
+	if returnTimeout == 0 {
+		returnTimeout = 60
+	}
+	if returnTimeout < 0 || returnTimeout > 120 {
+		msg := fmt.Sprintf("return timeout must be between 1 and 120 seconds, got %d", returnTimeout)
+		tflog.Error(ctx, msg)
+		return nil, errors.New(msg)
+	}
+	// the response timeout must leave time for ONTAP to wait for the job
+	if cxProfile.ResponseTimeout == 0 {
+		httpProfile.ResponseTimeout = time.Duration(returnTimeout+60) * time.Second
+	} else if cxProfile.ResponseTimeout <= time.Duration(returnTimeout)*time.Second {
+		msg := fmt.Sprintf("response timeout must be greater than return timeout %d seconds, got %s", returnTimeout, cxProfile.ResponseTimeout)
+		tflog.Error(ctx, msg)
+		return nil, errors.New(msg)
+	}
+	if err := cxProfile.Retry.validate(); err != nil {
+		tflog.Error(ctx, err.Error())
+		return nil, err
+	}
+	httpClient, err := httpclient.NewClient(ctx, httpProfile, tag)
+	if err != nil {
+		return nil, err
+	}
 	client := RestClient{
 		connectionProfile:     cxProfile,
 		ctx:                   ctx,
-		httpClient:            httpclient.NewClient(ctx, httpProfile, tag),
+		httpClient:            httpClient,
 		maxConcurrentRequests: maxConcurrentRequests,
 		mode:                  "prod",
 		requestSlots:          make(chan int, maxConcurrentRequests),
 		jobCompletionTimeOut:  jobCompletionTimeOut,
+		pageSize:              cxProfile.PageSize,
+		recordLimit:           cxProfile.RecordLimit,
+		retryPolicy:           cxProfile.Retry.withDefaults(),
+		returnTimeout:         returnTimeout,
 		tag:                   tag,
+		clusterInfo:           &clusterInfoCache{},
 	}
 	return &client, nil
 }
 
-func (r *RestClient) waitForAvailableSlot() {
-	r.requestSlots <- 1
+// waitForAvailableSlot blocks until a request slot is available, or the context is canceled
+func (r *RestClient) waitForAvailableSlot() error {
+	select {
+	case r.requestSlots <- 1:
+		return nil
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
 }
 
 func (r *RestClient) releaseSlot() {
@@ -213,6 +347,17 @@ func (q *RestQuery) Fields(fields []string) {
 	q.Set("fields", strings.Join(fields, ","))
 }
 
+// Clone returns a copy of the query, or an empty query if q is nil
+func (q *RestQuery) Clone() *RestQuery {
+	clone := &RestQuery{Values: url.Values{}}
+	if q != nil {
+		for key, values := range q.Values {
+			clone.Values[key] = append([]string(nil), values...)
+		}
+	}
+	return clone
+}
+
 // SetValues adds a set of key, value
 func (q *RestQuery) SetValues(keyValues map[string]interface{}) {
 	for k, v := range keyValues {
@@ -234,10 +379,15 @@ func (r *RestClient) Wait(uuid string) (int, RestResponse, error) {
 			if errorRetries <= 0 {
 				return statusCode, RestResponse{}, err
 			}
-			time.Sleep(10 * time.Second)
+			if err := r.sleep(10 * time.Second); err != nil {
+				return statusCode, RestResponse{}, fmt.Errorf("stopped waiting for job %s: %w", uuid, err)
+			}
 			errorRetries--
 			continue
 		}
+		if response == nil {
+			return statusCode, RestResponse{}, fmt.Errorf("job %s not found", uuid)
+		}
 		var job Job
 		if err := mapstructure.Decode(response, &job); err != nil {
 			tflog.Error(r.ctx, fmt.Sprintf("Read job data - decode error: %s, data: %#v", err, response))
@@ -254,12 +404,26 @@ func (r *RestClient) Wait(uuid string) (int, RestResponse, error) {
 			}
 			return statusCode, RestResponse{}, fmt.Errorf("fail to get job status. Unknown error")
 		}
-		time.Sleep(10 * time.Second)
+		if err := r.sleep(10 * time.Second); err != nil {
+			return statusCode, RestResponse{}, fmt.Errorf("stopped waiting for job %s: %w", uuid, err)
+		}
 	}
 	// TODO: clean up the resources in creation when errors out.
 	return 0, RestResponse{}, fmt.Errorf("fail to wait for job to finish. Exit now")
 }
 
+// sleep waits for duration, and returns early with an error if the context is canceled or its deadline is exceeded
+func (r *RestClient) sleep(duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
+}
+
 // Job is ONTAP API job data structure
 type Job struct {
 	State string
@@ -272,9 +436,28 @@ type jobError struct {
 	Target  string `tfsdk:"target"`
 }
 
+// WithContext returns a copy of the client bound to ctx, and using tag for telemetry.
+// The copy shares the HTTP transport, the request slots and the cluster information with the original client,
+// so that a single client can be used by all resources and data sources for a connection profile.
+func (r *RestClient) WithContext(ctx context.Context, tag string) *RestClient {
+	client := *r
+	client.ctx = ctx
+	client.tag = tag
+	client.httpClient = r.httpClient.WithContext(ctx, tag)
+	return &client
+}
+
+// WithJobCompletionTimeOut returns a copy of the client, waiting up to timeout seconds for jobs to complete.
+// It is used to apply the timeouts block of a resource to a single operation.
+func (r *RestClient) WithJobCompletionTimeOut(timeout int) *RestClient {
+	client := *r
+	client.jobCompletionTimeOut = timeout
+	return &client
+}
+
 // Equals is a test function for Unit Testing
 func (r *RestClient) Equals(r2 *RestClient) (ok bool, firstDiff string) {
-	if r.connectionProfile != r2.connectionProfile {
+	if !reflect.DeepEqual(r.connectionProfile, r2.connectionProfile) {
 		return false, fmt.Sprintf("expected %#v, got %#v", r.connectionProfile, r2.connectionProfile)
 	}
 	if r.tag != r2.tag {
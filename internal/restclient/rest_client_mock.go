@@ -2,21 +2,60 @@ package restclient
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
 )
 
 // MockResponse is used in Unit Testing to mock expected REST responses.
-// It validate sthat the request matches ExpectedMethod and ExpectedURL, to return the other elements.
+// It validates that the request matches ExpectedMethod and ExpectedURL, and ExpectedQuery and ExpectedBody when set,
+// to return the other elements.
 type MockResponse struct {
 	ExpectedMethod string
 	ExpectedURL    string
 	StatusCode     int
 	Response       RestResponse
 	Err            error
+	// ExpectedQuery is encoded as in a URL, eg "fields=name,uuid&name=vol1".  The order of the parameters does not matter.
+	ExpectedQuery string
+	// ExpectedBody is compared with the request body after a JSON round trip, so that eg 10 and int64(10) are equal.
+	ExpectedBody map[string]interface{}
+}
+
+// MockTestingT is the part of testing.TB used by the mocked client
+type MockTestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Cleanup(func())
+}
+
+// mockResponses holds the responses that were not consumed yet.
+// They are shared with the copies of the client, as the interfaces functions receive the client by value.
+type mockResponses struct {
+	t         MockTestingT
+	responses []MockResponse
+	// unordered responses can be consumed in any order, eg for concurrent calls
+	unordered bool
+	mutex     sync.Mutex
 }
 
 // NewMockedRestClient is used in Unit Testing to mock expected REST responses.
-func NewMockedRestClient(responses []MockResponse) (*RestClient, error) {
+// The requests must be sent in the same order as responses.  A request that does not match the next response, or a
+// response that is not consumed when the test ends, fails the test.
+func NewMockedRestClient(t MockTestingT, responses []MockResponse) (*RestClient, error) {
+	return newMockedRestClient(t, responses, false)
+}
+
+// NewUnorderedMockedRestClient is used in Unit Testing to mock expected REST responses, when the requests are not sent
+// in a known order.  Each request consumes the first remaining response that matches it.
+func NewUnorderedMockedRestClient(t MockTestingT, responses []MockResponse) (*RestClient, error) {
+	return newMockedRestClient(t, responses, true)
+}
+
+func newMockedRestClient(t MockTestingT, responses []MockResponse, unordered bool) (*RestClient, error) {
 	cxProfile := ConnectionProfile{
 		Hostname: "",
 		Username: "",
@@ -27,21 +66,124 @@ func NewMockedRestClient(responses []MockResponse) (*RestClient, error) {
 		panic(err)
 	}
 	restclient.mode = "mock"
-	restclient.responses = responses
+	// retries are tested separately, and would consume the mocked responses
+	restclient.retryPolicy.MaxAttempts = 1
+	mock := &mockResponses{t: t, responses: append([]MockResponse(nil), responses...), unordered: unordered}
+	restclient.mock = mock
+	t.Cleanup(func() {
+		for _, response := range mock.remaining() {
+			t.Errorf("Expected request not sent: %s", response.describe())
+		}
+	})
 	return restclient, nil
 }
 
 func (c *RestClient) mockCallAPIMethod(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
-	if len(c.responses) == 0 {
-		panic(fmt.Sprintf("Unexpected request: %s %s", method, baseURL))
+	mock := c.mock
+	mock.t.Helper()
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	request := fmt.Sprintf("%s %s", method, baseURL)
+	if query != nil && len(query.Values) != 0 {
+		request += "?" + query.Encode()
+	}
+	if len(mock.responses) == 0 {
+		mock.t.Errorf("Unexpected request: %s", request)
+		return 0, RestResponse{}, fmt.Errorf("unexpected request: %s", request)
+	}
+	for index, expectedResponse := range mock.responses {
+		mismatch := expectedResponse.mismatch(method, baseURL, query, body)
+		if mismatch == "" {
+			// remove element now that we know it is consumed
+			mock.responses = append(mock.responses[:index], mock.responses[index+1:]...)
+			return expectedResponse.StatusCode, expectedResponse.Response, expectedResponse.Err
+		}
+		if !mock.unordered {
+			mock.t.Errorf("Unexpected request: %s, expecting %s: %s", request, expectedResponse.describe(), mismatch)
+			return 0, RestResponse{}, fmt.Errorf("unexpected request: %s", request)
+		}
+	}
+	mock.t.Errorf("Unexpected request: %s, no matching response in %d remaining responses", request, len(mock.responses))
+	return 0, RestResponse{}, fmt.Errorf("unexpected request: %s", request)
+}
+
+// remaining returns the responses that were not consumed
+func (m *mockResponses) remaining() []MockResponse {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]MockResponse(nil), m.responses...)
+}
+
+// mismatch describes how a request differs from the expected request, or returns an empty string if they match
+func (r MockResponse) mismatch(method string, baseURL string, query *RestQuery, body map[string]interface{}) string {
+	if r.ExpectedMethod != method {
+		return fmt.Sprintf("method %s, expecting %s", method, r.ExpectedMethod)
 	}
-	expectedResponse := c.responses[0]
-	if expectedResponse.ExpectedMethod != method || expectedResponse.ExpectedURL != baseURL {
-		if len(c.responses) == 0 {
-			panic(fmt.Sprintf("Unexpected request: %s %s, expecting %s %s", method, baseURL, expectedResponse.ExpectedMethod, expectedResponse.ExpectedURL))
+	// some tests use a leading /, the client does not
+	if strings.TrimPrefix(r.ExpectedURL, "/") != strings.TrimPrefix(baseURL, "/") {
+		return fmt.Sprintf("URL %s, expecting %s", baseURL, r.ExpectedURL)
+	}
+	if r.ExpectedQuery != "" {
+		expected, err := url.ParseQuery(r.ExpectedQuery)
+		if err != nil {
+			return fmt.Sprintf("invalid ExpectedQuery %s: %s", r.ExpectedQuery, err)
+		}
+		actual := url.Values{}
+		if query != nil {
+			actual = query.Values
+		}
+		if expected.Encode() != actual.Encode() {
+			return fmt.Sprintf("query %s, expecting %s", actual.Encode(), expected.Encode())
+		}
+	}
+	if r.ExpectedBody != nil {
+		expected, err := normalizeMockBody(r.ExpectedBody)
+		if err != nil {
+			return fmt.Sprintf("invalid ExpectedBody %#v: %s", r.ExpectedBody, err)
+		}
+		actual, err := normalizeMockBody(body)
+		if err != nil {
+			return fmt.Sprintf("invalid body %#v: %s", body, err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			expectedJSON, _ := json.Marshal(expected)
+			actualJSON, _ := json.Marshal(actual)
+			return fmt.Sprintf("body %s, expecting %s", actualJSON, expectedJSON)
+		}
+	}
+	return ""
+}
+
+// describe returns the expected request, for error messages
+func (r MockResponse) describe() string {
+	description := fmt.Sprintf("%s %s", r.ExpectedMethod, r.ExpectedURL)
+	if r.ExpectedQuery != "" {
+		description += "?" + r.ExpectedQuery
+	}
+	return description
+}
+
+// normalizeMockBody converts a body to the values produced by decoding JSON, so that bodies can be compared
+func normalizeMockBody(body map[string]interface{}) (interface{}, error) {
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(content, &normalized)
+	return normalized, err
+}
+
+// NewMockedPagedResponses is used in Unit Testing to mock a collection GET split in several pages.
+// Each page but the last one reports a next link, so that GetZeroOrMoreRecords retrieves the following page.
+func NewMockedPagedResponses(baseURL string, statusCode int, pages [][]map[string]interface{}) []MockResponse {
+	responses := make([]MockResponse, 0, len(pages))
+	for index, page := range pages {
+		response := RestResponse{NumRecords: len(page), Records: page, StatusCode: statusCode}
+		if index < len(pages)-1 {
+			response.NextLink = fmt.Sprintf("/api/%s?max_records=%d&start.index=%d", baseURL, len(page), index+1)
 		}
+		responses = append(responses, MockResponse{ExpectedMethod: "GET", ExpectedURL: baseURL, StatusCode: statusCode, Response: response})
 	}
-	// remove element now that we know it is consumed
-	c.responses = c.responses[1:]
-	return expectedResponse.StatusCode, expectedResponse.Response, expectedResponse.Err
+	return responses
 }
@@ -0,0 +1,100 @@
+package restclient
+
+import (
+	"fmt"
+	"testing"
+)
+
+// fakeT records the errors reported by the mocked client
+type fakeT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Cleanup(cleanup func()) {
+	f.cleanups = append(f.cleanups, cleanup)
+}
+
+// end runs the cleanup functions, as the testing package does when a test ends
+func (f *fakeT) end() {
+	for _, cleanup := range f.cleanups {
+		cleanup()
+	}
+}
+
+type mockRequest struct {
+	method  string
+	baseURL string
+	query   map[string]string
+	body    map[string]interface{}
+}
+
+func TestRestClient_mockCallAPIMethod(t *testing.T) {
+	getVolume := MockResponse{ExpectedMethod: "GET", ExpectedURL: "storage/volumes", ExpectedQuery: "fields=name,uuid&name=vol1", StatusCode: 200}
+	getCluster := MockResponse{ExpectedMethod: "GET", ExpectedURL: "/cluster", StatusCode: 200}
+	patchVolume := MockResponse{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", ExpectedBody: map[string]interface{}{"size": 10, "nas": map[string]interface{}{"path": "/vol1"}}, StatusCode: 200}
+	tests := []struct {
+		name       string
+		responses  []MockResponse
+		unordered  bool
+		requests   []mockRequest
+		wantErrors int
+	}{
+		{name: "test_match", responses: []MockResponse{getVolume, getCluster}, requests: []mockRequest{
+			{method: "GET", baseURL: "storage/volumes", query: map[string]string{"name": "vol1", "fields": "name,uuid"}},
+			{method: "GET", baseURL: "cluster"},
+		}, wantErrors: 0},
+		{name: "test_body_match", responses: []MockResponse{patchVolume}, requests: []mockRequest{
+			{method: "PATCH", baseURL: "storage/volumes/1234", body: map[string]interface{}{"nas": map[string]interface{}{"path": "/vol1"}, "size": int64(10)}},
+		}, wantErrors: 0},
+		{name: "test_wrong_method", responses: []MockResponse{getCluster}, requests: []mockRequest{{method: "DELETE", baseURL: "cluster"}}, wantErrors: 2},
+		{name: "test_wrong_url", responses: []MockResponse{getCluster}, requests: []mockRequest{{method: "GET", baseURL: "cluster/nodes"}}, wantErrors: 2},
+		{name: "test_wrong_query", responses: []MockResponse{getVolume}, requests: []mockRequest{
+			{method: "GET", baseURL: "storage/volumes", query: map[string]string{"name": "vol2", "fields": "name,uuid"}},
+		}, wantErrors: 2},
+		{name: "test_wrong_body", responses: []MockResponse{patchVolume}, requests: []mockRequest{
+			{method: "PATCH", baseURL: "storage/volumes/1234", body: map[string]interface{}{"size": 20}},
+		}, wantErrors: 2},
+		{name: "test_wrong_order", responses: []MockResponse{getVolume, getCluster}, requests: []mockRequest{
+			{method: "GET", baseURL: "cluster"},
+		}, wantErrors: 3},
+		{name: "test_unordered", responses: []MockResponse{getVolume, getCluster}, unordered: true, requests: []mockRequest{
+			{method: "GET", baseURL: "cluster"},
+			{method: "GET", baseURL: "storage/volumes", query: map[string]string{"name": "vol1", "fields": "name,uuid"}},
+		}, wantErrors: 0},
+		{name: "test_unordered_no_match", responses: []MockResponse{getVolume, getCluster}, unordered: true, requests: []mockRequest{
+			{method: "GET", baseURL: "cluster"},
+			{method: "GET", baseURL: "cluster"},
+		}, wantErrors: 2},
+		{name: "test_unexpected", responses: []MockResponse{}, requests: []mockRequest{{method: "GET", baseURL: "cluster"}}, wantErrors: 1},
+		{name: "test_unconsumed", responses: []MockResponse{getCluster, getCluster}, requests: []mockRequest{{method: "GET", baseURL: "cluster"}}, wantErrors: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeT{}
+			var c *RestClient
+			if tt.unordered {
+				c, _ = NewUnorderedMockedRestClient(fake, tt.responses)
+			} else {
+				c, _ = NewMockedRestClient(fake, tt.responses)
+			}
+			for _, request := range tt.requests {
+				query := c.NewQuery()
+				for key, value := range request.query {
+					query.Set(key, value)
+				}
+				c.mockCallAPIMethod(request.method, request.baseURL, query, request.body)
+			}
+			fake.end()
+			if len(fake.errors) != tt.wantErrors {
+				t.Errorf("RestClient.mockCallAPIMethod() errors = %v, want %d errors", fake.errors, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -1,8 +1,15 @@
 package restclient
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestRestClient_GetNilOrOneRecord(t *testing.T) {
@@ -19,19 +26,40 @@ func TestRestClient_GetNilOrOneRecord(t *testing.T) {
 
 	responses := map[string][]MockResponse{
 		"test_no_records_1": {
-			{"GET", "cluster", 200, RestResponse{}, nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: RestResponse{}, Err: nil},
 		},
 		"test_no_records_2": {
-			{"GET", "cluster", 200, RestResponse{NumRecords: 0}, nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: RestResponse{NumRecords: 0}, Err: nil},
 		},
 		// "test_no_records_3": {
 		// 	{"GET", "cluster", 200, RestResponse{NumRecords: 1, Records: []map[string]interface{}{}}, nil},
 		// },
 		"test_one_record_1": {
-			{"GET", "cluster", 200, oneRecord, nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: oneRecord, Err: nil},
 		},
 		"test_two_records_1": {
-			{"GET", "cluster", 200, twoRecords, nil},
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 404, Response: RestResponse{}, Err: &ONTAPError{Code: "4", Message: "entry doesn't exist", StatusCode: 404}},
+		},
+		"test_not_found_2": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 404, Response: RestResponse{}, Err: &ONTAPError{StatusCode: 404}},
+		},
+		"test_svm_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 400, Response: RestResponse{}, Err: &ONTAPError{Code: "2621462", Message: "SVM \"svm1\" does not exist", StatusCode: 400}},
+		},
+		"test_svm_not_found_2": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 404, Response: RestResponse{}, Err: &ONTAPError{Code: "2621462", Message: "SVM \"svm1\" does not exist", StatusCode: 404}},
+		},
+		"test_message_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 400, Response: RestResponse{}, Err: &ONTAPError{Code: "123", Message: "entry doesn't exist", StatusCode: 400}},
+		},
+		"test_api_not_found_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 404, Response: RestResponse{}, Err: &ONTAPError{Code: "3", Message: "API not found", StatusCode: 404}},
+		},
+		"test_server_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 500, Response: RestResponse{}, Err: &ONTAPError{StatusCode: 500}},
 		},
 	}
 	tests := []struct {
@@ -47,11 +75,18 @@ func TestRestClient_GetNilOrOneRecord(t *testing.T) {
 		// {name: "test_no_records_3", responses: responses["test_no_records_3"], args: args{baseURL: "cluster"}, want: 200, want1: nil, wantErr: false},
 		{name: "test_one_record_1", responses: responses["test_one_record_1"], args: args{baseURL: "cluster"}, want: 200, want1: record, wantErr: false},
 		{name: "test_two_records_1", responses: responses["test_two_records_1"], args: args{baseURL: "cluster"}, want: 200, want1: nil, wantErr: true},
+		{name: "test_not_found_1", responses: responses["test_not_found_1"], args: args{baseURL: "cluster"}, want: 404, want1: nil, wantErr: false},
+		{name: "test_not_found_2", responses: responses["test_not_found_2"], args: args{baseURL: "cluster"}, want: 404, want1: nil, wantErr: false},
+		{name: "test_svm_not_found_1", responses: responses["test_svm_not_found_1"], args: args{baseURL: "cluster"}, want: 400, want1: nil, wantErr: true},
+		{name: "test_svm_not_found_2", responses: responses["test_svm_not_found_2"], args: args{baseURL: "cluster"}, want: 404, want1: nil, wantErr: true},
+		{name: "test_message_not_found_1", responses: responses["test_message_not_found_1"], args: args{baseURL: "cluster"}, want: 400, want1: nil, wantErr: true},
+		{name: "test_api_not_found_1", responses: responses["test_api_not_found_1"], args: args{baseURL: "cluster"}, want: 404, want1: nil, wantErr: true},
+		{name: "test_server_error_1", responses: responses["test_server_error_1"], args: args{baseURL: "cluster"}, want: 500, want1: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
-			c, err := NewMockedRestClient(tt.responses)
+			c, err := NewMockedRestClient(t, tt.responses)
 			if err != nil {
 				panic(err)
 			}
@@ -69,3 +104,292 @@ func TestRestClient_GetNilOrOneRecord(t *testing.T) {
 		})
 	}
 }
+
+func TestRestClient_GetZeroOrMoreRecords(t *testing.T) {
+	record1 := map[string]any{"name": "one"}
+	record2 := map[string]any{"name": "two"}
+	record3 := map[string]any{"name": "three"}
+	pages := [][]map[string]any{{record1, record2}, {record3}}
+
+	tests := []struct {
+		name        string
+		responses   []MockResponse
+		recordLimit int
+		want        []map[string]any
+		wantErr     bool
+	}{
+		{name: "test_one_page", responses: NewMockedPagedResponses("storage/volumes", 200, pages[:1]), want: []map[string]any{record1, record2}, wantErr: false},
+		{name: "test_two_pages", responses: NewMockedPagedResponses("storage/volumes", 200, pages), want: []map[string]any{record1, record2, record3}, wantErr: false},
+		{name: "test_record_limit", responses: NewMockedPagedResponses("storage/volumes", 200, pages)[:1], recordLimit: 1, want: []map[string]any{record1}, wantErr: false},
+		{name: "test_error_on_next_page", responses: []MockResponse{
+			NewMockedPagedResponses("storage/volumes", 200, pages)[0],
+			{ExpectedMethod: "GET", ExpectedURL: "storage/volumes", StatusCode: 500, Response: RestResponse{}, Err: errors.New("generic error for UT")},
+		}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			c.recordLimit = tt.recordLimit
+			_, got, err := c.GetZeroOrMoreRecords("storage/volumes", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.GetZeroOrMoreRecords() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestClient.GetZeroOrMoreRecords() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestClient_GetZeroOrMoreRecords_pageSize(t *testing.T) {
+	responses := []MockResponse{
+		{ExpectedMethod: "GET", ExpectedURL: "storage/volumes", StatusCode: 200, Response: RestResponse{}, ExpectedQuery: "fields=name&max_records=2"},
+		{ExpectedMethod: "GET", ExpectedURL: "storage/volumes", StatusCode: 200, Response: RestResponse{}, ExpectedQuery: "fields=name&max_records=2"},
+	}
+	c, err := NewMockedRestClient(t, responses)
+	if err != nil {
+		panic(err)
+	}
+	c.pageSize = 2
+	query := c.NewQuery()
+	query.Fields([]string{"name"})
+	// the query is reused, max_records is added to a copy
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.GetZeroOrMoreRecords("storage/volumes", query, nil); err != nil {
+			t.Fatalf("RestClient.GetZeroOrMoreRecords() unexpected error = %v", err)
+		}
+		if query.Get("max_records") != "" {
+			t.Errorf("RestClient.GetZeroOrMoreRecords() modified the query: %v", query.Values)
+		}
+	}
+}
+
+func TestRestClient_parseNextLink(t *testing.T) {
+	c, err := NewMockedRestClient(t, nil)
+	if err != nil {
+		panic(err)
+	}
+	baseURL, query, err := c.parseNextLink("/api/storage/volumes?fields=name%2Cuuid&max_records=2&start.uuid=123")
+	if err != nil {
+		t.Fatalf("RestClient.parseNextLink() unexpected error = %v", err)
+	}
+	if baseURL != "storage/volumes" {
+		t.Errorf("RestClient.parseNextLink() baseURL = %v, want %v", baseURL, "storage/volumes")
+	}
+	if query.Get("start.uuid") != "123" || query.Get("max_records") != "2" || query.Get("fields") != "name,uuid" {
+		t.Errorf("RestClient.parseNextLink() unexpected query = %v", query.Values)
+	}
+}
+
+func TestRestClient_Wait_canceled(t *testing.T) {
+	running := RestResponse{NumRecords: 1, Records: []map[string]any{{"state": "running"}}}
+	success := RestResponse{NumRecords: 1, Records: []map[string]any{{"state": "success"}}}
+	failure := RestResponse{NumRecords: 1, Records: []map[string]any{{"state": "failure", "error": map[string]any{"code": "123", "message": "failed"}}}}
+	tests := []struct {
+		name      string
+		responses []MockResponse
+		cancel    bool
+		wantErr   error
+	}{
+		{name: "test_success", responses: []MockResponse{{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/1234", StatusCode: 200, Response: success, Err: nil}}, cancel: false, wantErr: nil},
+		{name: "test_failure", responses: []MockResponse{{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/1234", StatusCode: 200, Response: failure, Err: nil}}, cancel: false, wantErr: errors.New("fail to get job status. Error code: 123. Message: failed, Target: ")},
+		{name: "test_canceled", responses: []MockResponse{{ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/1234", StatusCode: 200, Response: running, Err: nil}}, cancel: true, wantErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			c.ctx = ctx
+			if tt.cancel {
+				cancel()
+			} else {
+				defer cancel()
+			}
+			start := time.Now()
+			_, _, err = c.Wait("1234")
+			if time.Since(start) > 5*time.Second {
+				t.Errorf("RestClient.Wait() did not return promptly")
+			}
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("RestClient.Wait() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || (!errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("RestClient.Wait() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewClient_returnTimeout(t *testing.T) {
+	tests := []struct {
+		name            string
+		returnTimeout   int
+		responseTimeout time.Duration
+		want            int
+		wantErr         bool
+	}{
+		{name: "test_default", returnTimeout: 0, want: 60, wantErr: false},
+		{name: "test_set", returnTimeout: 120, want: 120, wantErr: false},
+		{name: "test_too_large", returnTimeout: 121, wantErr: true},
+		{name: "test_response_timeout", returnTimeout: 120, responseTimeout: 121 * time.Second, want: 120, wantErr: false},
+		{name: "test_response_timeout_equal", returnTimeout: 120, responseTimeout: 120 * time.Second, wantErr: true},
+		{name: "test_response_timeout_default_return_timeout", returnTimeout: 0, responseTimeout: 30 * time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient(context.Background(), ConnectionProfile{ReturnTimeout: tt.returnTimeout, ResponseTimeout: tt.responseTimeout}, "resource/version", 600)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && c.returnTimeout != tt.want {
+				t.Errorf("NewClient() returnTimeout = %v, want %v", c.returnTimeout, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClient_apiRoot(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"name": "cluster1"}`))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		panic(err)
+	}
+	port, err := strconv.Atoi(serverURL.Port())
+	if err != nil {
+		panic(err)
+	}
+	tests := []struct {
+		name    string
+		apiRoot string
+		scheme  string
+		port    int
+		want    string
+		wantErr bool
+	}{
+		{name: "test_default", apiRoot: "", scheme: "http", port: port, want: "/api/cluster", wantErr: false},
+		{name: "test_sub_path", apiRoot: "/ontap1/api/", scheme: "http", port: port, want: "/ontap1/api/cluster", wantErr: false},
+		{name: "test_bad_scheme", apiRoot: "", scheme: "ftp", port: port, wantErr: true},
+		{name: "test_bad_port", apiRoot: "", scheme: "http", port: 65536, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path = ""
+			cxProfile := ConnectionProfile{Hostname: serverURL.Hostname(), Port: tt.port, Scheme: tt.scheme, APIRoot: tt.apiRoot, Retry: RetryPolicy{MaxAttempts: 1}}
+			c, err := NewClient(context.Background(), cxProfile, "resource/version", 600)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if _, _, err := c.GetNilOrOneRecord("cluster", nil, nil); err != nil {
+				t.Errorf("GetNilOrOneRecord() error = %v", err)
+			}
+			if path != tt.want {
+				t.Errorf("NewClient() sent request to %s, want %s", path, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestClient_WithJobCompletionTimeOut(t *testing.T) {
+	c, err := NewMockedRestClient(t, nil)
+	if err != nil {
+		panic(err)
+	}
+	c.jobCompletionTimeOut = 600
+	client := c.WithJobCompletionTimeOut(30)
+	if client.jobCompletionTimeOut != 30 {
+		t.Errorf("RestClient.WithJobCompletionTimeOut() jobCompletionTimeOut = %v, want 30", client.jobCompletionTimeOut)
+	}
+	if c.jobCompletionTimeOut != 600 {
+		t.Errorf("RestClient.WithJobCompletionTimeOut() modified the original client, jobCompletionTimeOut = %v", c.jobCompletionTimeOut)
+	}
+}
+
+func TestRestClient_CallDeleteMethod(t *testing.T) {
+	accepted := RestResponse{Job: map[string]any{"uuid": "1234"}}
+	success := RestResponse{NumRecords: 1, Records: []map[string]any{{"state": "success"}}}
+	failure := RestResponse{NumRecords: 1, Records: []map[string]any{{"state": "failure", "error": map[string]any{"code": "917536", "message": "volume in use", "target": "vol1"}}}}
+	tests := []struct {
+		name      string
+		responses []MockResponse
+		want      int
+		wantErr   bool
+	}{
+		{name: "test_no_job", responses: []MockResponse{{ExpectedMethod: "DELETE", ExpectedURL: "storage/volumes/1", StatusCode: 200, Response: RestResponse{}, Err: nil}}, want: 200, wantErr: false},
+		{name: "test_job_success", responses: []MockResponse{{ExpectedMethod: "DELETE", ExpectedURL: "storage/volumes/1", StatusCode: 202, Response: accepted, Err: nil}, {ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/1234", StatusCode: 200, Response: success, Err: nil}}, want: 200, wantErr: false},
+		{name: "test_job_failure", responses: []MockResponse{{ExpectedMethod: "DELETE", ExpectedURL: "storage/volumes/1", StatusCode: 202, Response: accepted, Err: nil}, {ExpectedMethod: "GET", ExpectedURL: "cluster/jobs/1234", StatusCode: 200, Response: failure, Err: nil}}, want: 200, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			c.jobCompletionTimeOut = 60
+			got, _, err := c.CallDeleteMethod("storage/volumes/1", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.CallDeleteMethod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RestClient.CallDeleteMethod() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestClient_CallCreateMethod_query(t *testing.T) {
+	c, err := NewMockedRestClient(t, []MockResponse{
+		{ExpectedMethod: "POST", ExpectedURL: "storage/volumes", ExpectedQuery: "return_records=true&return_timeout=60", StatusCode: 201, Response: RestResponse{}, Err: nil},
+	})
+	if err != nil {
+		panic(err)
+	}
+	c.returnTimeout = 60
+	query := c.NewQuery()
+	query.Set("return_records", "true")
+	if _, _, err := c.CallCreateMethod("storage/volumes", query, nil); err != nil {
+		t.Errorf("RestClient.CallCreateMethod() error = %v", err)
+	}
+	if query.Has("return_timeout") {
+		t.Errorf("RestClient.CallCreateMethod() added return_timeout to the caller's query: %s", query.Encode())
+	}
+}
+
+func TestRestClient_WithContext(t *testing.T) {
+	c, err := NewClient(context.Background(), ConnectionProfile{MaxConcurrentRequests: 2}, "resource/version", 600)
+	if err != nil {
+		panic(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := c.WithContext(ctx, "other/version")
+	if client.ctx != ctx || client.tag != "other/version" {
+		t.Errorf("RestClient.WithContext() context or tag not set, got tag %s", client.tag)
+	}
+	if c.ctx == ctx || c.tag != "resource/version" {
+		t.Errorf("RestClient.WithContext() modified the original client, got tag %s", c.tag)
+	}
+	if client.requestSlots != c.requestSlots || client.clusterInfo != c.clusterInfo {
+		t.Errorf("RestClient.WithContext() request slots and cluster info are expected to be shared")
+	}
+}
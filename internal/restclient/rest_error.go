@@ -0,0 +1,159 @@
+package restclient
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ONTAPError is returned when ONTAP reports an error in the response, or an error status code without details
+type ONTAPError struct {
+	Code       string
+	Message    string
+	Target     string
+	StatusCode int
+	Method     string
+	URL        string
+}
+
+// Error returns a readable message, including the request when known
+func (e *ONTAPError) Error() string {
+	var msg string
+	if e.Code == "" {
+		msg = fmt.Sprintf("statusCode indicates error, without details: %d", e.StatusCode)
+	} else {
+		msg = fmt.Sprintf("ONTAP error %s: %s", e.Code, e.Message)
+		if e.Target != "" {
+			msg += fmt.Sprintf(", target: %s", e.Target)
+		}
+		msg += fmt.Sprintf(", statusCode: %d", e.StatusCode)
+	}
+	if e.Method != "" {
+		msg = fmt.Sprintf("%s %s: %s", e.Method, e.URL, msg)
+	}
+	return msg
+}
+
+// ErrorCategory groups ONTAP errors with a common cause, with a clear summary and a remediation hint
+type ErrorCategory struct {
+	Summary string
+	Hint    string
+}
+
+// Error categories for common ONTAP errors
+var (
+	ErrorCategoryDuplicate = &ErrorCategory{
+		Summary: "object already exists",
+		Hint:    "An object with the same name or key already exists on the cluster. Use terraform import to manage it, or choose a different name.",
+	}
+	ErrorCategoryNotFound = &ErrorCategory{
+		Summary: "object not found",
+		Hint:    "The object, or an object it references, does not exist on the cluster. Check the names used in the configuration, eg svm_name.",
+	}
+	ErrorCategoryInUse = &ErrorCategory{
+		Summary: "object in use",
+		Hint:    "The object is still used by other objects, eg volumes in an aggregate or an SVM. Delete or move them first, or use depends_on to order the operations.",
+	}
+	ErrorCategoryPermissionDenied = &ErrorCategory{
+		Summary: "permission denied",
+		Hint:    "The ONTAP account used in the connection profile does not have a role allowing this operation. Cluster level objects require a cluster account.",
+	}
+	ErrorCategoryAuthentication = &ErrorCategory{
+		Summary: "authentication failed",
+		Hint:    "Check username and password, or client_certificate and client_key, in the connection profile.",
+	}
+	ErrorCategoryLicenseMissing = &ErrorCategory{
+		Summary: "license missing",
+		Hint:    "The feature requires a license that is not installed on the cluster. Add it with the netapp-ontap_cluster_licensing_license_resource resource.",
+	}
+	ErrorCategoryInvalidArgument = &ErrorCategory{
+		Summary: "invalid argument",
+		Hint:    "The attribute is not supported by the ONTAP version of the cluster, or cannot be used with the other attributes.",
+	}
+)
+
+// errorCodeCategories maps ONTAP error codes to a category
+var errorCodeCategories = map[string]*ErrorCategory{
+	// duplicate entry
+	"1": ErrorCategoryDuplicate,
+	// entry doesn't exist
+	"4": ErrorCategoryNotFound,
+	// not authorized for that command
+	"6": ErrorCategoryPermissionDenied,
+	// unexpected argument
+	"262179": ErrorCategoryInvalidArgument,
+	// SVM not found
+	"2621462": ErrorCategoryNotFound,
+	// LUN already mapped to the igroup
+	"5374922": ErrorCategoryDuplicate,
+	// export policy rule already exists
+	"1704070": ErrorCategoryDuplicate,
+	// SVM name already exists
+	"13434908": ErrorCategoryDuplicate,
+}
+
+// errorStatusCategories is used when the code is not in errorCodeCategories
+var errorStatusCategories = map[int]*ErrorCategory{
+	401: ErrorCategoryAuthentication,
+	403: ErrorCategoryPermissionDenied,
+	404: ErrorCategoryNotFound,
+	409: ErrorCategoryDuplicate,
+}
+
+// errorMessageCategories is a last resort when neither the code nor the status code is recognized.
+// Patterns match whole words, so that eg "in user" is not reported as "in use".
+var errorMessageCategories = []struct {
+	pattern  *regexp.Regexp
+	category *ErrorCategory
+}{
+	{regexp.MustCompile(`\b(duplicate entry|already exists)\b`), ErrorCategoryDuplicate},
+	{regexp.MustCompile(`\bentry doesn't exist\b`), ErrorCategoryNotFound},
+	{regexp.MustCompile(`\bin use\b`), ErrorCategoryInUse},
+	{regexp.MustCompile(`\b(not licensed|requires (a|an|the) ([\w-]+ )?license|license (is )?(missing|required|not installed))\b`), ErrorCategoryLicenseMissing},
+	{regexp.MustCompile(`\b(not authorized|permission denied)\b`), ErrorCategoryPermissionDenied},
+}
+
+// Category returns the category for the error, or nil if it is not recognized
+func (e *ONTAPError) Category() *ErrorCategory {
+	if category, ok := errorCodeCategories[e.Code]; ok {
+		return category
+	}
+	if category, ok := errorStatusCategories[e.StatusCode]; ok {
+		return category
+	}
+	message := strings.ToLower(e.Message)
+	for _, entry := range errorMessageCategories {
+		if entry.pattern.MatchString(message) {
+			return entry.category
+		}
+	}
+	return nil
+}
+
+// errorCodeEntryNotFound is reported when the requested object does not exist
+const errorCodeEntryNotFound = "4"
+
+// errorCodeAPINotFound is reported with a 404 status code when the endpoint itself does not exist, eg on an older ONTAP version
+const errorCodeAPINotFound = "3"
+
+// IsNotFound checks whether ONTAP reported that the requested object does not exist.
+// Transport errors, and requests to an endpoint that is not supported, are not reported as not found.
+func IsNotFound(err error) bool {
+	var ontapErr *ONTAPError
+	if !errors.As(err, &ontapErr) || ontapErr.Code == errorCodeAPINotFound {
+		return false
+	}
+	return ontapErr.Category() == ErrorCategoryNotFound
+}
+
+// isRecordNotFound checks whether ONTAP reported that the object read with a GET does not exist,
+// with the entry doesn't exist code, or a 404 status code without details.
+// Other errors, eg a missing SVM or a message that looks like not found, are reported to the caller.
+func isRecordNotFound(err error) bool {
+	var ontapErr *ONTAPError
+	if !errors.As(err, &ontapErr) {
+		return false
+	}
+	return ontapErr.Code == errorCodeEntryNotFound || (ontapErr.Code == "" && ontapErr.StatusCode == 404)
+}
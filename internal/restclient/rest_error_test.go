@@ -0,0 +1,98 @@
+package restclient
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestONTAPError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ONTAPError
+		want string
+	}{
+		{name: "test_rest_error", err: &ONTAPError{Code: "4", Message: "entry doesn't exist", Target: "uuid", StatusCode: 404, Method: "GET", URL: "storage/volumes/1234"},
+			want: "GET storage/volumes/1234: ONTAP error 4: entry doesn't exist, target: uuid, statusCode: 404"},
+		{name: "test_no_target", err: &ONTAPError{Code: "4", Message: "entry doesn't exist", StatusCode: 404}, want: "ONTAP error 4: entry doesn't exist, statusCode: 404"},
+		{name: "test_status_code", err: &ONTAPError{StatusCode: 503, Method: "GET", URL: "cluster"}, want: "GET cluster: statusCode indicates error, without details: 503"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("ONTAPError.Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestONTAPError_Category(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ONTAPError
+		want *ErrorCategory
+	}{
+		{name: "test_code", err: &ONTAPError{Code: "2621462", Message: "SVM \"svm1\" does not exist", StatusCode: 400}, want: ErrorCategoryNotFound},
+		{name: "test_code_duplicate", err: &ONTAPError{Code: "1", Message: "duplicate entry", StatusCode: 409}, want: ErrorCategoryDuplicate},
+		{name: "test_code_svm_duplicate", err: &ONTAPError{Code: "13434908", Message: "Vserver name \"svm5\" is already in use", StatusCode: 400}, want: ErrorCategoryDuplicate},
+		{name: "test_code_permission", err: &ONTAPError{Code: "6", Message: "not authorized for that command", StatusCode: 403}, want: ErrorCategoryPermissionDenied},
+		{name: "test_code_before_status", err: &ONTAPError{Code: "4", Message: "entry doesn't exist", StatusCode: 409}, want: ErrorCategoryNotFound},
+		{name: "test_status_before_message", err: &ONTAPError{Code: "123", Message: "Volume vol1 is in use by a LUN", StatusCode: 403}, want: ErrorCategoryPermissionDenied},
+		{name: "test_message_duplicate", err: &ONTAPError{Code: "123", Message: "Export policy \"default\" already exists", StatusCode: 400}, want: ErrorCategoryDuplicate},
+		{name: "test_message_in_use", err: &ONTAPError{Code: "123", Message: "Volume vol1 is in use by a LUN", StatusCode: 400}, want: ErrorCategoryInUse},
+		{name: "test_message_license", err: &ONTAPError{Code: "123", Message: "This operation requires a SnapMirror license", StatusCode: 400}, want: ErrorCategoryLicenseMissing},
+		{name: "test_message_not_licensed", err: &ONTAPError{Code: "123", Message: "FlexCache is not licensed", StatusCode: 400}, want: ErrorCategoryLicenseMissing},
+		{name: "test_message_permission", err: &ONTAPError{Code: "123", Message: "User is not authorized to run this command", StatusCode: 400}, want: ErrorCategoryPermissionDenied},
+		{name: "test_near_miss_in_user", err: &ONTAPError{Code: "123", Message: "Cannot find the group in user mapping", StatusCode: 400}, want: nil},
+		{name: "test_near_miss_in_user_name", err: &ONTAPError{Code: "123", Message: "Invalid character in username", StatusCode: 400}, want: nil},
+		{name: "test_near_miss_license_installed", err: &ONTAPError{Code: "123", Message: "The license is installed but the node is not eligible", StatusCode: 400}, want: nil},
+		{name: "test_near_miss_license_key", err: &ONTAPError{Code: "1115159", Message: "Invalid license key", StatusCode: 400}, want: nil},
+		{name: "test_near_miss_reentry", err: &ONTAPError{Code: "123", Message: "Nonduplicate entryway", StatusCode: 400}, want: nil},
+		{name: "test_status_code", err: &ONTAPError{StatusCode: 401}, want: ErrorCategoryAuthentication},
+		{name: "test_unknown", err: &ONTAPError{Code: "123", Message: "something else", StatusCode: 400}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Category(); got != tt.want {
+				t.Errorf("ONTAPError.Category() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestClient_checkRestErrors(t *testing.T) {
+	c, err := NewMockedRestClient(t, nil)
+	if err != nil {
+		panic(err)
+	}
+	response := RestResponse{RestError: RestError{Code: "4", Message: "entry doesn't exist", Target: "uuid"}}
+	_, err = c.checkRestErrors(404, response)
+	var ontapErr *ONTAPError
+	if !errors.As(err, &ontapErr) {
+		t.Fatalf("RestClient.checkRestErrors() expected ONTAPError, got %#v", err)
+	}
+	if ontapErr.Code != "4" || ontapErr.Target != "uuid" || ontapErr.StatusCode != 404 {
+		t.Errorf("RestClient.checkRestErrors() unexpected error %#v", ontapErr)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "test_entry_not_found", err: &ONTAPError{Code: "4", Message: "entry doesn't exist", StatusCode: 404}, want: true},
+		{name: "test_status_code", err: &ONTAPError{StatusCode: 404}, want: true},
+		{name: "test_api_not_found", err: &ONTAPError{Code: "3", Message: "API not found", StatusCode: 404}, want: false},
+		{name: "test_other_error", err: &ONTAPError{Code: "123", Message: "something else", StatusCode: 400}, want: false},
+		{name: "test_transport_error", err: errors.New("connection refused"), want: false},
+		{name: "test_nil", err: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
 )
 
 // RestError maps the REST error structure
@@ -25,6 +26,7 @@ type RestResponse struct {
 	ErrorType  string
 	Job        map[string]interface{}
 	Jobs       []map[string]interface{}
+	NextLink   string
 }
 
 // unmarshalResponse converts the REST response into a structure with a list of 0 or more records.
@@ -53,7 +55,7 @@ func (c *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 		emptyResponse.ErrorType = "bad_response_decode_json"
 		return statusCode, emptyResponse, err
 	}
-	tflog.Debug(c.ctx, fmt.Sprintf("dataMap %#v", dataMap))
+	tflog.Debug(c.ctx, fmt.Sprintf("dataMap %#v", httpclient.RedactBody(dataMap)))
 
 	// The returned REST response may or may not contain records.
 	// If records is not present, the contents will show in Other.
@@ -69,49 +71,53 @@ func (c *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 	var rawResponse restStagedResponse
 	var metadata mapstructure.Metadata
 	if err := mapstructure.DecodeMetadata(dataMap, &rawResponse, &metadata); err != nil {
-		tflog.Error(c.ctx, fmt.Sprintf("unable to format raw response, this may be expected when statusCode %d >= 300, unmarshall error=%s, response=%#v", statusCode, err, dataMap))
+		tflog.Error(c.ctx, fmt.Sprintf("unable to format raw response, this may be expected when statusCode %d >= 300, unmarshall error=%s, response=%#v", statusCode, err, httpclient.RedactBody(dataMap)))
 		emptyResponse.ErrorType = "bad_response_decode_interface"
 		return statusCode, emptyResponse, err
 	}
 
-	tflog.Debug(c.ctx, fmt.Sprintf("rawResponse %#v, metadata %#v", rawResponse, metadata))
+	tflog.Debug(c.ctx, fmt.Sprintf("rawResponse metadata %#v", metadata))
 
 	// If Other is present, add it to records.
 	// But ignore it if we already have some records.
-	// Other will always have 1 element called _link, so only do this if Other has more than 1 element
+	// Other will usually have 1 element called _links, so only do this if Other has another element.
+	// The private CLI only returns cli_output for some commands.
 	// Examples:
 	// {NumRecords:0 Records:[] Error:{Code: Message: Target:} Job:map[] Jobs:[] Other:map[_links:map[self:map[href:/api/cluster/schedules?fields=name%2Cuuid%2Ccron%2Cinterval%2Ctype%2Cscope&name=mytest]]]}
 	// {NumRecords:0 Records:[] Error:{Code: Message: Target:} Job:map[] Jobs:[] Other:map[_links:map[self:map[href:/api/cluster]] certificate:map[_links:map[self:map[href:/api/security/certificates/2f632ea7-92cd-11ed-8f2b-005056b3357c]] uuid:2f632ea7-92cd-11ed-8f2b-005056b3357c] metric:map[duration:PT15S iops:map[other:0 read:0 total:0 write:0] latency:map[other:0 read:0 total:0 write:0] status:ok throughput:map[other:0 read:0 total:0 write:0] timestamp:2023-03-16T18:36:30Z] name:laurentncluster-2 peering_policy:map[authentication_required:true encryption_required:false minimum_passphrase_length:8] san_optimized:false statistics:map[iops_raw:map[other:0 read:0 total:0 write:0] latency_raw:map[other:0 read:0 total:0 write:0] status:ok throughput_raw:map[other:0 read:0 total:0 write:0] timestamp:2023-03-16T18:36:31Z] timezone:map[name:Etc/UTC] uuid:2115008a-92cd-11ed-8f2b-005056b3357c version:map[full:NetApp Release Metropolitan__9.11.1: Sat Dec 10 19:08:07 UTC 2022 generation:9 major:11 minor:1]]}
-	if rawResponse.NumRecords == 0 && len(rawResponse.Records) == 0 && len(rawResponse.Other) > 1 {
+	_, hasLinks := rawResponse.Other["_links"]
+	if rawResponse.NumRecords == 0 && len(rawResponse.Records) == 0 && (len(rawResponse.Other) > 1 || len(rawResponse.Other) == 1 && !hasLinks) {
 		rawResponse.NumRecords = 1
 		rawResponse.Records = append(rawResponse.Records, rawResponse.Other)
 	}
 
 	var finalResponse RestResponse
 	if err := mapstructure.DecodeMetadata(rawResponse, &finalResponse, &metadata); err != nil {
-		tflog.Error(c.ctx, fmt.Sprintf("unable to format final response - statusCode %d, http err=%#v, decode error=%s, response=%#v", statusCode, httpClientErr, err, rawResponse))
+		tflog.Error(c.ctx, fmt.Sprintf("unable to format final response - statusCode %d, http err=%#v, decode error=%s, response=%#v", statusCode, httpClientErr, err, httpclient.RedactBody(dataMap)))
 		emptyResponse.ErrorType = "bad_response_decode_raw"
 		return statusCode, emptyResponse, err
 	}
 
 	// If we reached this point, the only possible errors are a bad HTTP status code and/or a REST error encoded in the paybload
 	finalResponse.StatusCode = statusCode
+	finalResponse.NextLink = getNextLink(dataMap)
 	finalResponse, err := c.checkRestErrors(statusCode, finalResponse)
-	tflog.Debug(c.ctx, fmt.Sprintf("finalResponse %#v, metadata %#v", finalResponse, metadata))
+	tflog.Debug(c.ctx, fmt.Sprintf("finalResponse %#v, metadata %#v", finalResponse.redacted(), metadata))
 	return statusCode, finalResponse, err
 }
 
 // check for statusCode and RestError
+// An ONTAPError is returned if either indicates an error.
 func (c *RestClient) checkRestErrors(statusCode int, response RestResponse) (RestResponse, error) {
 	var err error
 	if response.RestError.Code != "0" && response.RestError.Code != "" {
 		response.ErrorType = "rest_error"
-		err = fmt.Errorf("REST reported error %#v, statusCode: %d", response.RestError, statusCode)
+		err = &ONTAPError{Code: response.RestError.Code, Message: response.RestError.Message, Target: response.RestError.Target, StatusCode: statusCode}
 	} else if err = c.checkStatusCode(statusCode); err != nil {
 		response.ErrorType = "statuscode_error"
 	}
 	if err != nil {
-		tflog.Error(c.ctx, fmt.Sprintf("checkRestError: %s, statusCode %d, response: %#v", err, statusCode, response))
+		tflog.Error(c.ctx, fmt.Sprintf("checkRestError: %s, statusCode %d, response: %#v", err, statusCode, response.redacted()))
 	}
 	return response, err
 }
@@ -119,7 +125,38 @@ func (c *RestClient) checkRestErrors(statusCode int, response RestResponse) (Res
 // check for statusCode
 func (c *RestClient) checkStatusCode(statusCode int) error {
 	if statusCode >= 300 || statusCode < 200 {
-		return fmt.Errorf("statusCode indicates error, without details: %d", statusCode)
+		return &ONTAPError{StatusCode: statusCode}
 	}
 	return nil
 }
+
+// getNextLink returns the href for the next page of records, or an empty string when there are no more pages.
+// ONTAP reports it as _links.next.href when max_records is reached or return_timeout expires.
+func getNextLink(dataMap map[string]interface{}) string {
+	links, ok := dataMap["_links"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	next, ok := links["next"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	href, ok := next["href"].(string)
+	if !ok {
+		return ""
+	}
+	return href
+}
+
+// redacted returns a copy of the response for logging, with the values of sensitive fields redacted
+func (r RestResponse) redacted() RestResponse {
+	if r.Records == nil {
+		return r
+	}
+	records := make([]map[string]interface{}, len(r.Records))
+	for index, record := range r.Records {
+		records[index] = httpclient.RedactBody(record)
+	}
+	r.Records = records
+	return r
+}
@@ -56,6 +56,27 @@ func TestRestClient_unmarshalResponse(t *testing.T) {
 		Error: restError,
 	}
 	responseOther := map[string]any{"_link": "somelink", "option": "value"}
+	responseCLIOutput := RestResponse{
+		NumRecords: 1,
+		Records: []map[string]any{
+			{"cli_output": "done"},
+		},
+		StatusCode: 200}
+	responseLinksOnly := RestResponse{
+		NumRecords: 0,
+		Records:    []map[string]any(nil),
+		StatusCode: 200}
+	responseForJSONNext := map[string]any{
+		"num_records": 1,
+		"records": []map[string]any{
+			{"option": "value"},
+		},
+		"_links": map[string]any{
+			"self": map[string]any{"href": "/api/cluster?max_records=1"},
+			"next": map[string]any{"href": "/api/cluster?max_records=1&start.index=1"},
+		}}
+	responseNext := response
+	responseNext.NextLink = "/api/cluster?max_records=1&start.index=1"
 
 	rawEmpty := any(nil)
 	emptyJSON, err := json.Marshal(rawEmpty)
@@ -67,6 +88,10 @@ func TestRestClient_unmarshalResponse(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	responseJSONNext, err := json.Marshal(responseForJSONNext)
+	if err != nil {
+		panic(err)
+	}
 	responseJSONOther, err := json.Marshal(responseOther)
 	if err != nil {
 		panic(err)
@@ -97,7 +122,10 @@ func TestRestClient_unmarshalResponse(t *testing.T) {
 		{name: "error_mismatch_json", args: args{statusCode: 200, responseJSON: badJSON}, want: 200, want1: RestResponse{ErrorType: "bad_response_decode_interface", Records: []map[string]any{}, StatusCode: 200}, wantErr: true},
 		{name: "error_http_error", args: args{httpClientErr: genericError}, want: 0, want1: RestResponse{HTTPError: genericError.Error(), ErrorType: "http", Records: []map[string]any{}}, wantErr: true},
 		{name: "json_unmarshalled", args: args{statusCode: 200, responseJSON: responseJSON}, want: 200, want1: response, wantErr: false},
+		{name: "json_unmarshalled_next_link", args: args{statusCode: 200, responseJSON: responseJSONNext}, want: 200, want1: responseNext, wantErr: false},
 		{name: "json_unmarshalled_other", args: args{statusCode: 200, responseJSON: responseJSONOther}, want: 200, want1: responseOthers, wantErr: false},
+		{name: "json_unmarshalled_cli_output", args: args{statusCode: 200, responseJSON: []byte(`{"cli_output": "done"}`)}, want: 200, want1: responseCLIOutput, wantErr: false},
+		{name: "json_unmarshalled_links_only", args: args{statusCode: 200, responseJSON: []byte(`{"_links": {"self": {"href": "/api/cluster"}}}`)}, want: 200, want1: responseLinksOnly, wantErr: false},
 		{name: "rest_error", args: args{statusCode: 400, responseJSON: responseJSONRestError}, want: 400, want1: responseRestError, wantErr: true},
 		{name: "status_code_error_1", args: args{statusCode: 400, responseJSON: responseJSONRestError}, want: 400, want1: responseRestError, wantErr: true},
 		{name: "status_code_error_2", args: args{statusCode: 400, responseJSON: emptyJSON}, want: 400, want1: responseStatusCodeError, wantErr: true},
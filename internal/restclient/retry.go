@@ -0,0 +1,158 @@
+package restclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
+	"golang.org/x/exp/slices"
+)
+
+// RetryPolicy describes how to retry a REST call on transient failures, eg during a node takeover or a LIF migration.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.  1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the wait time before the first retry.  It doubles on each retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// RetryableStatusCodes are HTTP status codes indicating a transient failure.
+	RetryableStatusCodes []int
+	// RetryableErrorCodes are ONTAP REST error codes indicating a transient failure.
+	RetryableErrorCodes []string
+	// RetryNonIdempotent enables retries for POST and PATCH.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryableStatusCodes are retried unless RetryableStatusCodes is set in the connection profile
+var DefaultRetryableStatusCodes = []int{502, 503, 504}
+
+// DefaultRetryableErrorCodes are retried unless RetryableErrorCodes is set in the connection profile
+var DefaultRetryableErrorCodes = []string{
+	// a SnapMirror transfer is in progress on a broken off relationship
+	"13303808",
+	// a SnapMirror transfer is in progress
+	"13303812",
+	// a SnapMirror modification is in progress
+	"13303822",
+	// another job is already running on the same object
+	"460770",
+}
+
+// idempotentMethods can be sent again without side effects
+var idempotentMethods = []string{"GET", "HEAD", "OPTIONS", "PUT", "DELETE"}
+
+// withDefaults returns a copy of the policy, using default values for unset attributes
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = 3
+	}
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = 1 * time.Second
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = 30 * time.Second
+	}
+	if p.RetryableStatusCodes == nil {
+		p.RetryableStatusCodes = DefaultRetryableStatusCodes
+	}
+	if p.RetryableErrorCodes == nil {
+		p.RetryableErrorCodes = DefaultRetryableErrorCodes
+	}
+	return p
+}
+
+// validate reports inconsistent values
+func (p RetryPolicy) validate() error {
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("retry max attempts cannot be negative, got %d", p.MaxAttempts)
+	}
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("retry backoff cannot be negative, got %s and %s", p.InitialBackoff, p.MaxBackoff)
+	}
+	return nil
+}
+
+// isRetryable checks whether the failure is transient and the method can safely be sent again
+func (p RetryPolicy) isRetryable(method string, statusCode int, response RestResponse, err error) bool {
+	if err == nil {
+		return false
+	}
+	if !p.RetryNonIdempotent && !slices.Contains(idempotentMethods, method) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if response.RestError.Code != "" && slices.Contains(p.RetryableErrorCodes, response.RestError.Code) {
+		return true
+	}
+	if slices.Contains(p.RetryableStatusCodes, statusCode) {
+		return true
+	}
+	return isTransientNetworkError(err)
+}
+
+// isTransientNetworkError checks whether the connection failed or was interrupted, eg connection reset, connection refused, EOF.
+// TLS and certificate errors are not transient, they are reported as is.
+func isTransientNetworkError(err error) bool {
+	var pinErr *httpclient.CertificatePinError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certificateInvalidErr x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	if errors.As(err, &pinErr) || errors.As(err, &unknownAuthorityErr) || errors.As(err, &hostnameErr) ||
+		errors.As(err, &certificateInvalidErr) || errors.As(err, &recordHeaderErr) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		// TLS alerts sent or received during the handshake, eg bad certificate or protocol version
+		return opErr.Op != "remote error" && opErr.Op != "local error"
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// backoff returns the wait time before the next attempt, using exponential backoff with jitter.
+// attempt is 1 for the first retry.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// use a random value between wait/2 and wait, so that concurrent requests do not retry in lockstep
+	half := int64(wait / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// callAPIMethodWithRetries sends the request, and sends it again on transient failures according to the retry policy
+func (r *RestClient) callAPIMethodWithRetries(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	attempt := 1
+	for {
+		statusCode, response, err := r.callAPIMethodOnce(method, baseURL, query, body)
+		if attempt >= r.retryPolicy.MaxAttempts || !r.retryPolicy.isRetryable(method, statusCode, response, err) {
+			return statusCode, response, err
+		}
+		wait := r.retryPolicy.backoff(attempt)
+		tflog.Warn(r.ctx, fmt.Sprintf("retrying %s %s in %s, attempt %d of %d failed: %s, statusCode %d", method, baseURL, wait, attempt, r.retryPolicy.MaxAttempts, err, statusCode))
+		if err := r.sleep(wait); err != nil {
+			return statusCode, response, err
+		}
+		attempt++
+	}
+}
@@ -0,0 +1,192 @@
+package restclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient/httpclient"
+	"golang.org/x/exp/slices"
+)
+
+func TestRetryPolicy_isRetryable(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+	genericError := errors.New("generic error for UT")
+	connectionError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}
+	refusedError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}
+	eofError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: io.EOF}
+	unknownAuthorityError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: x509.UnknownAuthorityError{}}
+	hostnameError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: x509.HostnameError{Host: "host"}}
+	recordHeaderError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}}
+	pinError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: &httpclient.CertificatePinError{Message: "server certificate fingerprint does not match"}}
+	alertError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: &net.OpError{Op: "remote error", Err: errors.New("tls: bad certificate")}}
+	otherURLError := &url.Error{Op: "Get", URL: "https://host/api/cluster", Err: errors.New("stopped after 10 redirects")}
+	parseError := &url.Error{Op: "parse", URL: "https://host/api/%", Err: errors.New("invalid URL escape")}
+	busyResponse := RestResponse{RestError: RestError{Code: "13303812", Message: "transfer in progress"}}
+	jobConflictResponse := RestResponse{RestError: RestError{Code: "460770", Message: "another job is already running"}}
+	otherResponse := RestResponse{RestError: RestError{Code: "4", Message: "entry doesn't exist"}}
+
+	tests := []struct {
+		name       string
+		policy     RetryPolicy
+		method     string
+		statusCode int
+		response   RestResponse
+		err        error
+		want       bool
+	}{
+		{name: "test_no_error", policy: policy, method: "GET", statusCode: 200, err: nil, want: false},
+		{name: "test_status_503", policy: policy, method: "GET", statusCode: 503, err: genericError, want: true},
+		{name: "test_status_400", policy: policy, method: "GET", statusCode: 400, err: genericError, want: false},
+		{name: "test_rest_error_busy", policy: policy, method: "DELETE", statusCode: 409, response: busyResponse, err: genericError, want: true},
+		{name: "test_rest_error_other", policy: policy, method: "DELETE", statusCode: 404, response: otherResponse, err: genericError, want: false},
+		{name: "test_rest_error_job_conflict", policy: policy, method: "DELETE", statusCode: 409, response: jobConflictResponse, err: genericError, want: true},
+		{name: "test_connection_error", policy: policy, method: "GET", statusCode: -1, err: connectionError, want: true},
+		{name: "test_connection_refused", policy: policy, method: "GET", statusCode: -1, err: refusedError, want: true},
+		{name: "test_eof", policy: policy, method: "GET", statusCode: -1, err: eofError, want: true},
+		{name: "test_unknown_authority", policy: policy, method: "GET", statusCode: -1, err: unknownAuthorityError, want: false},
+		{name: "test_hostname_mismatch", policy: policy, method: "GET", statusCode: -1, err: hostnameError, want: false},
+		{name: "test_not_tls", policy: policy, method: "GET", statusCode: -1, err: recordHeaderError, want: false},
+		{name: "test_fingerprint_mismatch", policy: policy, method: "GET", statusCode: -1, err: pinError, want: false},
+		{name: "test_tls_alert", policy: policy, method: "GET", statusCode: -1, err: alertError, want: false},
+		{name: "test_other_url_error", policy: policy, method: "GET", statusCode: -1, err: otherURLError, want: false},
+		{name: "test_parse_error", policy: policy, method: "GET", statusCode: -1, err: parseError, want: false},
+		{name: "test_canceled", policy: policy, method: "GET", statusCode: -1, err: context.Canceled, want: false},
+		{name: "test_post_not_idempotent", policy: policy, method: "POST", statusCode: 503, err: genericError, want: false},
+		{name: "test_post_non_idempotent_enabled", policy: RetryPolicy{RetryNonIdempotent: true}.withDefaults(), method: "POST", statusCode: 503, err: genericError, want: true},
+		{name: "test_custom_status_codes", policy: RetryPolicy{RetryableStatusCodes: []int{429}}.withDefaults(), method: "GET", statusCode: 503, err: genericError, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.isRetryable(tt.method, tt.statusCode, tt.response, tt.err); got != tt.want {
+				t.Errorf("RetryPolicy.isRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_withDefaults(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+	// SnapMirror busy and job conflict errors are retried by default
+	for _, code := range []string{"13303808", "13303812", "13303822", "460770"} {
+		if !slices.Contains(policy.RetryableErrorCodes, code) {
+			t.Errorf("RetryPolicy.withDefaults() RetryableErrorCodes = %v, want %s", policy.RetryableErrorCodes, code)
+		}
+	}
+	// the codes from the connection profile replace the defaults
+	policy = RetryPolicy{RetryableErrorCodes: []string{"917927"}}.withDefaults()
+	if !slices.Equal(policy.RetryableErrorCodes, []string{"917927"}) {
+		t.Errorf("RetryPolicy.withDefaults() RetryableErrorCodes = %v, want [917927]", policy.RetryableErrorCodes)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 2 * time.Second, MaxBackoff: 10 * time.Second}
+	tests := []struct {
+		name    string
+		attempt int
+		max     time.Duration
+	}{
+		{name: "test_first_retry", attempt: 1, max: 2 * time.Second},
+		{name: "test_second_retry", attempt: 2, max: 4 * time.Second},
+		{name: "test_third_retry", attempt: 3, max: 8 * time.Second},
+		{name: "test_capped", attempt: 10, max: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.backoff(tt.attempt)
+			if got < tt.max/2 || got > tt.max {
+				t.Errorf("RetryPolicy.backoff() = %v, want between %v and %v", got, tt.max/2, tt.max)
+			}
+		})
+	}
+}
+
+func TestRestClient_callAPIMethodWithRetries(t *testing.T) {
+	unavailable := MockResponse{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 503, Response: RestResponse{}, Err: errors.New("statusCode indicates error, without details: 503")}
+	ok := MockResponse{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: RestResponse{NumRecords: 0}, Err: nil}
+	tests := []struct {
+		name      string
+		responses []MockResponse
+		method    string
+		want      int
+		wantErr   bool
+	}{
+		{name: "test_success_after_retry", responses: []MockResponse{unavailable, ok}, method: "GET", want: 200, wantErr: false},
+		{name: "test_max_attempts", responses: []MockResponse{unavailable, unavailable, unavailable}, method: "GET", want: 503, wantErr: true},
+		{name: "test_post_no_retry", responses: []MockResponse{{ExpectedMethod: "POST", ExpectedURL: "cluster", StatusCode: 503, Response: RestResponse{}, Err: errors.New("statusCode indicates error, without details: 503")}}, method: "POST", want: 503, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(t, tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			c.retryPolicy = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}.withDefaults()
+			got, _, err := c.callAPIMethod(tt.method, "cluster", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestClient.callAPIMethod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RestClient.callAPIMethod() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsTransientNetworkError_server(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(serverURL.Port())
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closedURL, _ := url.Parse(closed.URL)
+	closedPort, _ := strconv.Atoi(closedURL.Port())
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		profile httpclient.HTTPProfile
+		want    bool
+	}{
+		{name: "test_unknown_authority", profile: httpclient.HTTPProfile{APIRoot: "api", Hostname: serverURL.Hostname(), Port: port, ValidateCerts: true}, want: false},
+		{name: "test_fingerprint_mismatch", profile: httpclient.HTTPProfile{APIRoot: "api", Hostname: serverURL.Hostname(), Port: port, CertificateFingerprint: strings.Repeat("00", 32)}, want: false},
+		{name: "test_connection_refused", profile: httpclient.HTTPProfile{APIRoot: "api", Scheme: "http", Hostname: closedURL.Hostname(), Port: closedPort}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := httpclient.NewClient(context.Background(), tt.profile, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = client.Do("cluster", &httpclient.Request{Method: "GET"})
+			if err == nil {
+				t.Fatal("HTTPClient.Do() expected an error")
+			}
+			if got := isTransientNetworkError(err); got != tt.want {
+				t.Errorf("isTransientNetworkError(%s) = %v, want %v", err, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 )
 
 // ErrorHandler creates an error handler to combine logging and reporting errors
@@ -40,12 +43,51 @@ func (e *ErrorHandler) MakeAndLogError(msg string) error {
 // The error is added to the diagnostic and will be reported by Terraform
 func (e *ErrorHandler) MakeAndReportError(summary string, msg string) error {
 	e.validate()
-	fullMsg := fmt.Sprintf("HERE  %s: %s", summary, msg)
+	fullMsg := fmt.Sprintf("%s: %s", summary, msg)
 	tflog.SubsystemError(e.subCtx, e.name, msg)
 	e.diags.AddError(summary, msg)
 	return errors.New(fullMsg)
 }
 
+// MakeAndReportRestError is similar to MakeAndReportError, for an error returned by the REST client.
+// When err is an ONTAP error in the catalogue, the summary and a remediation hint are added to the diagnostic.
+// When the ONTAP target matches an attribute, the diagnostic is reported against this attribute.
+func (e *ErrorHandler) MakeAndReportRestError(summary string, msg string, err error) error {
+	e.validate()
+	var ontapErr *restclient.ONTAPError
+	if !errors.As(err, &ontapErr) {
+		return e.MakeAndReportError(summary, msg)
+	}
+	detail := msg
+	if category := ontapErr.Category(); category != nil {
+		summary = fmt.Sprintf("%s: %s", summary, category.Summary)
+		detail = fmt.Sprintf("%s\n\n%s", msg, category.Hint)
+	}
+	fullMsg := fmt.Sprintf("%s: %s", summary, msg)
+	tflog.SubsystemError(e.subCtx, e.name, msg, map[string]any{"code": ontapErr.Code, "target": ontapErr.Target, "statusCode": ontapErr.StatusCode})
+	if attributePath, ok := targetAttributePath(ontapErr.Target); ok {
+		e.diags.AddAttributeError(attributePath, summary, detail)
+	} else {
+		e.diags.AddError(summary, detail)
+	}
+	return errors.New(fullMsg)
+}
+
+// simpleTarget matches a top level field, eg name or comment
+var simpleTarget = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// targetAttributePath maps an ONTAP target to an attribute path.
+// Top level fields usually use the same name in the provider, and svm.name is svm_name.
+func targetAttributePath(target string) (path.Path, bool) {
+	if target == "svm.name" {
+		return path.Root("svm_name"), true
+	}
+	if simpleTarget.MatchString(target) {
+		return path.Root(target), true
+	}
+	return path.Empty(), false
+}
+
 func (e *ErrorHandler) validate() {
 	if e == nil {
 		panic("Error handler is not set")